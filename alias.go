@@ -0,0 +1,75 @@
+package sdulid
+
+import "sync/atomic"
+
+// AliasedKind is implemented by kinds that were renamed. Their deprecated short idents are still
+// accepted when decoding IDs from text, while the current short ident is used when encoding. This
+// way a rename doesn't break IDs that are stored or in flight, e.g: accept "usr_" while emitting "mbr_".
+type AliasedKind interface {
+	Kind
+	// KindAliases returns the deprecated short idents of the kind.
+	KindAliases() []string
+}
+
+// AliasHook is called when an ID is decoded from text with a deprecated alias of its kind.
+type AliasHook func(kind KindInfo, alias string)
+
+// aliasHook holds the package-wide alias hook.
+var aliasHook atomic.Pointer[AliasHook]
+
+// SetAliasHook configures fn to be called whenever an ID is decoded with a deprecated alias, e.g. to
+// log a warning or count the remaining usage before the alias is removed. A nil fn disables it. It is
+// safe for concurrent use but is meant to be called once during program initialization.
+func SetAliasHook(fn AliasHook) {
+	if fn == nil {
+		aliasHook.Store(nil)
+
+		return
+	}
+
+	aliasHook.Store(&fn)
+}
+
+// GetAliasHook returns the configured alias hook, or nil if there is none.
+func GetAliasHook() AliasHook {
+	if fn := aliasHook.Load(); fn != nil {
+		return *fn
+	}
+
+	return nil
+}
+
+// shortIdents returns the short ident of kind followed by its aliases, if any.
+func shortIdents(kind Kind) []string {
+	idents := []string{kind.KindShortIdent()}
+	if aliased, ok := kind.(AliasedKind); ok {
+		idents = append(idents, aliased.KindAliases()...)
+	}
+
+	return idents
+}
+
+// isAlias reports whether prefix is a deprecated alias of kind and calls the alias hook if it is.
+func isAlias[S ~string | ~[]byte](kind Kind, prefix S) bool {
+	aliased, ok := kind.(AliasedKind)
+	if !ok {
+		return false
+	}
+
+	for _, alias := range aliased.KindAliases() {
+		if alias == string(prefix) {
+			notifyAlias(kind, alias)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// notifyAlias calls the configured alias hook.
+func notifyAlias(kind Kind, alias string) {
+	if fn := GetAliasHook(); fn != nil {
+		fn(KindOfFor(kind), alias)
+	}
+}
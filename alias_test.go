@@ -0,0 +1,53 @@
+package sdulid_test
+
+import (
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type renamedID struct{}
+
+func (renamedID) KindNumber() uint16     { return 12 }
+func (renamedID) KindIdent() string      { return "renamed" }
+func (renamedID) KindShortIdent() string { return "rnm" }
+func (renamedID) KindAliases() []string  { return []string{"old"} }
+
+var _ = Describe("aliases", func() {
+	var used []string
+	BeforeEach(func() {
+		used = nil
+		sdulid.SetAliasHook(func(kind sdulid.KindInfo, alias string) {
+			used = append(used, kind.ShortIdent+"<"+alias)
+		})
+		DeferCleanup(sdulid.SetAliasHook, sdulid.AliasHook(nil))
+	})
+
+	It("should parse ids with a deprecated alias", func() {
+		id := sdulid.MustParse[renamedID]("old_01JBRQS1J5A085FYY2M7ZX00")
+		Expect(id.String()).To(Equal("rnm_01JBRQS1J5A085FYY2M7ZX00"))
+		Expect(used).To(Equal([]string{"rnm<old"}))
+
+		Expect(sdulid.MustParse[renamedID]("rnm_01JBRQS1J5A085FYY2M7ZX00")).To(Equal(id))
+		Expect(used).To(HaveLen(1))
+
+		_, err := sdulid.Parse[renamedID]("foo_01JBRQS1J5A085FYY2M7ZX00")
+		Expect(err).To(MatchError(&sdulid.WrongKindError{Expected: "rnm", Actual: "foo"}))
+	})
+
+	It("should parse aliases in a registry", func() {
+		reg := sdulid.MustNewRegistry(testID{}, renamedID{})
+
+		id, kind, err := reg.ParseAny("old_01JBRQS1J5A085FYY2M7ZX00")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kind).To(Equal(renamedID{}))
+		Expect(id.String()).To(Equal("rnm_01JBRQS1J5A085FYY2M7ZX00"))
+		Expect(used).To(Equal([]string{"rnm<old"}))
+	})
+
+	It("should report collisions with aliases", func() {
+		_, err := sdulid.NewRegistry(renamedID{}, runtimeKind{"older", "old"})
+		Expect(err).To(MatchError(sdulid.ErrKindCollision))
+		Expect(err).To(MatchError(ContainSubstring(`short ident "old" of "older" is already used by "renamed"`)))
+	})
+})
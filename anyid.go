@@ -0,0 +1,135 @@
+package sdulid
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// ErrRegistryRequired is returned when an AnyID is decoded without a registry to resolve its kind.
+var ErrRegistryRequired = errors.New("sdulid: AnyID requires a registry to decode, see Registry.UnmarshalAny")
+
+// AnyID is a self-describing ULID of which the kind is only known at runtime. It is returned when
+// parsing IDs of unknown static type through a Registry and allows IDs of many kinds to be stored in
+// a single (heterogeneous) collection. It is immutable, the kind and the ULID always agree.
+type AnyID struct {
+	u    ulid.ULID
+	kind Kind
+}
+
+// Any returns the id as an AnyID that carries the kind at runtime.
+func (id ID[T]) Any() AnyID {
+	var kind T
+
+	return AnyID{u: id.ULID, kind: kind}
+}
+
+// To converts the type-erased id back into an ID of kind T. It returns a WrongKindError if the id
+// describes a different kind.
+func To[T Kind](id AnyID) (ID[T], error) {
+	var kind T
+	if num := binary.BigEndian.Uint16(id.u[14:]); num != kind.KindNumber() {
+		actual := strconv.Itoa(int(num))
+		if id.kind != nil {
+			actual = id.kind.KindShortIdent()
+		}
+
+		return ID[T]{}, &WrongKindError{Expected: kind.KindShortIdent(), Actual: actual}
+	}
+
+	return ID[T]{ULID: id.u}, nil
+}
+
+// NewFor is like New but for a kind that is only known at runtime, it returns the ID as an AnyID.
+func NewFor(kind Kind, opts ...Option) (AnyID, error) {
+	u, err := newULID(opts)
+	if err != nil {
+		return AnyID{}, err
+	}
+
+	binary.BigEndian.PutUint16(u[14:], kind.KindNumber())
+
+	return AnyID{u: u, kind: kind}, nil
+}
+
+// Kind returns the kind that the ID describes, it is nil for the zero value.
+func (id AnyID) Kind() Kind {
+	return id.kind
+}
+
+// Compare returns an integer comparing the id to other lexicographically by their bytes. Since the
+// kind suffix is part of the bytes, IDs with the same timestamp and entropy are ordered by kind.
+func (id AnyID) Compare(other AnyID) int {
+	return id.u.Compare(other.u)
+}
+
+// ULID returns a copy of the underlying ULID.
+func (id AnyID) ULID() ulid.ULID {
+	return id.u
+}
+
+// Bytes returns the 16 raw bytes of the id.
+func (id AnyID) Bytes() []byte {
+	return id.u.Bytes()
+}
+
+// Time returns the time encoded in the id.
+func (id AnyID) Time() time.Time {
+	return ulid.Time(id.u.Time())
+}
+
+// Timestamp returns the time encoded in the id as Unix milliseconds.
+func (id AnyID) Timestamp() uint64 {
+	return id.u.Time()
+}
+
+// IsZero returns true if the timestamp and entropy are all zero, see ID.IsZero.
+func (id AnyID) IsZero() bool {
+	return [14]byte(id.u[:14]) == [14]byte{}
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface by returning the 16 raw bytes.
+func (id AnyID) MarshalBinary() ([]byte, error) {
+	return id.u.Bytes(), nil
+}
+
+// Value implements the sql/driver.Valuer interface by returning the 16 raw bytes of the id.
+func (id AnyID) Value() (driver.Value, error) {
+	return id.u.Bytes(), nil
+}
+
+// String returns the prefixed text form. If the id has no kind it returns the long form.
+func (id AnyID) String() string {
+	if id.kind == nil {
+		return id.u.String()
+	}
+
+	d, _ := id.MarshalText()
+
+	return string(d)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface by returning the prefixed text form.
+// It returns ErrUnknownKind if the id has no kind.
+func (id AnyID) MarshalText() ([]byte, error) {
+	if id.kind == nil {
+		return nil, ErrUnknownKind
+	}
+
+	shortIdent := id.kind.KindShortIdent()
+	dst := make([]byte, len(shortIdent)+1+ulid.EncodedSize-binary.Size(uint16(0)))
+	encodeText(dst, shortIdent, id.u)
+
+	return dst, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, it always returns
+// ErrRegistryRequired since the kind of the text can only be resolved through a registry. Decode the
+// text with Registry.UnmarshalAny instead.
+func (id *AnyID) UnmarshalText([]byte) error {
+	return ErrRegistryRequired
+}
@@ -0,0 +1,106 @@
+package sdulid_test
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+
+	"github.com/advdv/sdulid"
+	"github.com/oklog/ulid/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("any id", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should type-erase and convert back", func() {
+		aid := id1.Any()
+		Expect(aid.Kind()).To(Equal(testID{}))
+		Expect(aid.String()).To(Equal("tst_01JBRQS1J5A085FYY2M7ZXXZ"))
+
+		id2, err := sdulid.To[testID](aid)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(id2).To(Equal(id1))
+
+		_, err = sdulid.To[otherID](aid)
+		Expect(err).To(MatchError(sdulid.ErrWrongKind))
+
+		var wkerr *sdulid.WrongKindError
+		Expect(errors.As(err, &wkerr)).To(BeTrue())
+		Expect(*wkerr).To(Equal(sdulid.WrongKindError{Expected: "oth", Actual: "tst"}))
+	})
+
+	It("should generate for a runtime kind", func() {
+		aid, err := sdulid.NewFor(otherID{}, sdulid.WithTimestampMS(1730628322885))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(aid.Kind()).To(Equal(otherID{}))
+		Expect(aid.Timestamp()).To(Equal(uint64(1730628322885)))
+		Expect(aid.Time()).To(Equal(ulid.Time(1730628322885)))
+
+		id2, err := sdulid.To[otherID](aid)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(id2.Any()).To(Equal(aid))
+
+		_, err = sdulid.NewFor(otherID{}, sdulid.WithTimestampMS(ulid.MaxTime()+1))
+		Expect(err).To(MatchError(ulid.ErrBigTime))
+	})
+
+	It("should marshal text", func() {
+		txt, err := sdulid.MustFromULID[otherID]("01JBRQS1J5A085FYY2M7ZXWG00").Any().MarshalText()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(txt)).To(Equal("oth_01JBRQS1J5A085FYY2M7ZXW0"))
+	})
+
+	It("should round-trip json through a registry", func() {
+		type entry struct {
+			Subject sdulid.AnyID `json:"subject"`
+		}
+
+		data, err := json.Marshal(entry{Subject: id1.Any()})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(`{"subject":"tst_01JBRQS1J5A085FYY2M7ZXXZ"}`))
+
+		var decoded entry
+		Expect(json.Unmarshal(data, &decoded)).To(MatchError(sdulid.ErrRegistryRequired))
+
+		var raw struct {
+			Subject json.RawMessage `json:"subject"`
+		}
+		Expect(json.Unmarshal(data, &raw)).To(Succeed())
+
+		var subject string
+		Expect(json.Unmarshal(raw.Subject, &subject)).To(Succeed())
+		reg := sdulid.MustNewRegistry(testID{}, otherID{})
+		Expect(reg.UnmarshalAny([]byte(subject), &decoded.Subject)).To(Succeed())
+		Expect(decoded.Subject).To(Equal(id1.Any()))
+		Expect(decoded.Subject.ULID()).To(Equal(id1.ULID))
+	})
+
+	It("should handle zero value without kind", func() {
+		var aid sdulid.AnyID
+		Expect(aid.Kind()).To(BeNil())
+		Expect(aid.String()).To(Equal("00000000000000000000000000"))
+		Expect(aid.IsZero()).To(BeTrue())
+
+		_, err := aid.MarshalText()
+		Expect(err).To(MatchError(sdulid.ErrUnknownKind))
+	})
+
+	It("should sort heterogeneous ids", func() {
+		ids := []sdulid.AnyID{
+			sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00").Any(),
+			sdulid.MustFromULID[otherID]("01JBRQS1J5A085FYY2M7ZXWG00").Any(),
+			sdulid.MustFromULID[testID]("01JBRQS1J40000000000000000").Any(),
+		}
+
+		slices.SortFunc(ids, sdulid.AnyID.Compare)
+		Expect(ids[0].String()).To(Equal("tst_01JBRQS1J40000000000001Z"))
+		Expect(ids[1].Kind()).To(Equal(otherID{}))
+		Expect(ids[2].Kind()).To(Equal(testID{}))
+	})
+})
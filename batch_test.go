@@ -0,0 +1,59 @@
+package sdulid_test
+
+import (
+	"bytes"
+	"testing/iotest"
+	"time"
+
+	"github.com/advdv/sdulid"
+	"github.com/oklog/ulid/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("batch", func() {
+	It("should make unique ids of the kind", func() {
+		ids := sdulid.MakeBatch[testID](1000)
+		Expect(ids).To(HaveLen(1000))
+
+		seen := map[sdulid.ID[testID]]bool{}
+		for _, id := range ids {
+			Expect(id.Bytes()[14:]).To(Equal([]byte{255, 255}))
+			Expect(id.Time()).To(Equal(ids[0].Time()))
+			Expect(seen).ToNot(HaveKey(id))
+			seen[id] = true
+		}
+
+		Expect(sdulid.CryptoMaker[testID]{}.MakeBatch(3)).To(HaveLen(3))
+	})
+
+	It("should use the options", func() {
+		ids, err := sdulid.NewBatch[testID](2,
+			sdulid.WithTime(time.UnixMilli(1730628322885)),
+			sdulid.WithEntropy(bytes.NewReader(bytes.Repeat([]byte{1}, 16))))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ids[0]).To(Equal(ids[1]))
+		Expect(ids[0].Bytes()).To(Equal([]byte{1, 146, 241, 124, 134, 69, 1, 1, 1, 1, 1, 1, 1, 1, 255, 255}))
+	})
+
+	It("should return errors", func() {
+		_, err := sdulid.NewBatch[testID](1, sdulid.WithTimestampMS(ulid.MaxTime()+1))
+		Expect(err).To(MatchError(ulid.ErrBigTime))
+
+		_, err = sdulid.NewBatch[testID](1, sdulid.WithEntropy(iotest.ErrReader(iotest.ErrTimeout)))
+		Expect(err).To(MatchError(iotest.ErrTimeout))
+
+		Expect(func() { sdulid.MakeBatch[testID](1, sdulid.WithTimestampMS(ulid.MaxTime()+1)) }).To(Panic())
+	})
+
+	It("should validate the batch size", func() {
+		_, err := sdulid.NewBatch[testID](-1)
+		Expect(err).To(MatchError(sdulid.ErrInvalidBatchSize))
+		Expect(func() { sdulid.CryptoMaker[testID]{}.MakeBatch(-1) }).To(PanicWith(MatchError(sdulid.ErrInvalidBatchSize)))
+
+		ids, err := sdulid.NewBatch[testID](0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ids).To(BeEmpty())
+		Expect(ids).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,188 @@
+package sdulid_test
+
+import (
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/advdv/sdulid"
+)
+
+// stringSink keeps the compiler from eliding the strings in the String benchmarks.
+var stringSink string
+
+func BenchmarkMarshalText(b *testing.B) {
+	id := sdulid.Make[testID]()
+
+	b.ReportAllocs()
+	for range b.N {
+		_, _ = id.MarshalText()
+	}
+}
+
+func BenchmarkAppendText(b *testing.B) {
+	id := sdulid.Make[testID]()
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for range b.N {
+		buf, _ = id.AppendText(buf[:0])
+	}
+}
+
+func BenchmarkMarshalTextTo(b *testing.B) {
+	id := sdulid.Make[testID]()
+	buf := make([]byte, id.EncodedSize())
+
+	b.ReportAllocs()
+	for range b.N {
+		_ = id.MarshalTextTo(buf)
+	}
+}
+
+// BenchmarkAppendTextWidths covers the encoding of narrow and wide IDs, which copy the cached prefix of
+// their kind just like ID.
+func BenchmarkAppendTextWidths(b *testing.B) {
+	buf := make([]byte, 0, 64)
+
+	b.Run("narrow", func(b *testing.B) {
+		id := sdulid.Make8[narrowID]()
+
+		b.ReportAllocs()
+		for range b.N {
+			buf, _ = id.AppendText(buf[:0])
+		}
+	})
+
+	b.Run("wide", func(b *testing.B) {
+		id := sdulid.Make24[wideID]()
+
+		b.ReportAllocs()
+		for range b.N {
+			buf, _ = id.AppendText(buf[:0])
+		}
+	})
+}
+
+func BenchmarkMarshalTextToParallel(b *testing.B) {
+	id := sdulid.Make[testID]()
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		buf := make([]byte, id.EncodedSize())
+		for pb.Next() {
+			_ = id.MarshalTextTo(buf)
+		}
+	})
+}
+
+func BenchmarkSliceMarshalText(b *testing.B) {
+	ids := sdulid.IDSlice[testID](sdulid.MakeBatch[testID](100))
+
+	b.ReportAllocs()
+	for range b.N {
+		_, _ = ids.MarshalText()
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	id := sdulid.Make[testID]()
+
+	b.ReportAllocs()
+	for range b.N {
+		stringSink = id.String()
+	}
+}
+
+func BenchmarkUnmarshalText(b *testing.B) {
+	txt, _ := sdulid.Make[testID]().MarshalText()
+
+	b.ReportAllocs()
+	for range b.N {
+		var id sdulid.ID[testID]
+		_ = id.UnmarshalText(txt)
+	}
+}
+
+func BenchmarkMakeLoop(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		ids := make([]sdulid.ID[testID], 0, 100)
+		for range 100 {
+			ids = append(ids, sdulid.CryptoMaker[testID]{}.Make())
+		}
+	}
+}
+
+func BenchmarkMakeBatch(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		_ = sdulid.MakeBatch[testID](100)
+	}
+}
+
+func BenchmarkNewParallel(b *testing.B) {
+	for name, entropy := range map[string]io.Reader{
+		"crypto": rand.Reader,
+		"pool":   sdulid.NewEntropyPool(0),
+	} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					_, _ = sdulid.New[testID](sdulid.WithEntropy(entropy))
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkEntropyRead(b *testing.B) {
+	for name, entropy := range map[string]io.Reader{
+		"crypto": rand.Reader,
+		"pool":   sdulid.NewEntropyPool(0),
+	} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.RunParallel(func(pb *testing.PB) {
+				var buf [10]byte
+				for pb.Next() {
+					_, _ = entropy.Read(buf[:])
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkMakePolicy(b *testing.B) {
+	for name, mk := range map[string]func(...sdulid.Option) sdulid.ID[testID]{
+		"secure": sdulid.MakeSecure[testID],
+		"fast":   sdulid.MakeFast[testID],
+	} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				_ = mk()
+			}
+		})
+	}
+}
+
+func BenchmarkValidate(b *testing.B) {
+	txt := sdulid.Make[testID]().String()
+
+	b.ReportAllocs()
+	for range b.N {
+		_ = sdulid.Validate[testID](txt)
+	}
+}
+
+func BenchmarkStringCache(b *testing.B) {
+	cache := sdulid.NewStringCache[testID](0)
+	id := sdulid.Make[testID]()
+
+	b.ReportAllocs()
+	for range b.N {
+		stringSink = cache.String(id)
+	}
+}
@@ -0,0 +1,83 @@
+package sdulid
+
+import "github.com/oklog/ulid/v2"
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface by returning the 16 raw bytes.
+func (id ID[T]) MarshalBinary() ([]byte, error) {
+	return id.ULID.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface. Unlike the embedded ULID, it
+// requires the trailing two bytes to describe T. It returns ulid.ErrDataSize when data is not 16
+// bytes and ErrInvalidSuffix when it holds an ID of another kind.
+func (id *ID[T]) UnmarshalBinary(data []byte) error {
+	if len(data) != len(id.ULID) {
+		return ulid.ErrDataSize
+	}
+
+	return id.scanBytes(data)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface. Like ID.UnmarshalBinary, it
+// requires the last byte to describe T.
+func (id *ID8[T]) UnmarshalBinary(data []byte) error {
+	if len(data) != len(id.ULID) {
+		return ulid.ErrDataSize
+	}
+
+	return id.Scan(data)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface. Like ID.UnmarshalBinary, it
+// requires the last 3 bytes to describe T.
+func (id *ID24[T]) UnmarshalBinary(data []byte) error {
+	if len(data) != len(id.ULID) {
+		return ulid.ErrDataSize
+	}
+
+	return id.Scan(data)
+}
+
+// Array returns the 16 raw bytes of the id as an array, which doesn't allocate unlike Bytes.
+func (id ID[T]) Array() [16]byte {
+	return id.ULID
+}
+
+// Bytes16 returns a pointer to the 16 raw bytes of the id, so they can be read or copied without
+// allocating. Writes through the pointer are not checked, the trailing two bytes must describe T.
+func (id *ID[T]) Bytes16() *[16]byte {
+	return (*[16]byte)(&id.ULID)
+}
+
+// entropySize is the number of random bytes in an ID, between the timestamp and the kind suffix.
+const entropySize = 8
+
+// Entropy returns the 8 random bytes of the id (bytes 6 to 13). Unlike the entropy of the embedded
+// ULID it excludes the kind suffix, which is constant for all IDs of T.
+func (id ID[T]) Entropy() (e [entropySize]byte) {
+	copy(e[:], id.ULID[6:14])
+
+	return e
+}
+
+// SetEntropy sets the 8 random bytes of the id and leaves the kind suffix untouched. Unlike the
+// embedded ULID it returns ulid.ErrDataSize for anything but 8 bytes, so a 10 byte ULID entropy can't
+// overwrite the suffix.
+func (id *ID[T]) SetEntropy(e []byte) error {
+	if len(e) != entropySize {
+		return ulid.ErrDataSize
+	}
+
+	copy(id.ULID[6:14], e)
+
+	return nil
+}
+
+// FromBytes returns the ID of kind T that is held by the 16 raw bytes in b, e.g. as read from an index
+// or wire protocol. It returns ulid.ErrDataSize when b is not 16 bytes, or ErrInvalidSuffix when the
+// trailing two bytes don't describe T.
+func FromBytes[T Kind](b []byte) (id ID[T], err error) {
+	err = id.UnmarshalBinary(b)
+
+	return id, err
+}
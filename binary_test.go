@@ -0,0 +1,69 @@
+package sdulid_test
+
+import (
+	"encoding"
+
+	"github.com/advdv/sdulid"
+	"github.com/oklog/ulid/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = sdulid.ID[testID]{}
+	_ encoding.BinaryUnmarshaler = &sdulid.ID[testID]{}
+)
+
+var _ = Describe("binary", func() {
+	It("should round trip the raw bytes", func() {
+		id := sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+
+		data, err := id.MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(Equal(id.Bytes()))
+
+		var got sdulid.ID[testID]
+		Expect(got.UnmarshalBinary(data)).To(Succeed())
+		Expect(got).To(Equal(id))
+	})
+
+	It("should check the kind and size", func() {
+		data, err := sdulid.Make[otherID]().MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+
+		var id sdulid.ID[testID]
+		Expect(id.UnmarshalBinary(data)).To(MatchError(sdulid.ErrInvalidSuffix))
+		Expect(id.UnmarshalBinary(data[:15])).To(MatchError(ulid.ErrDataSize))
+		Expect(id).To(Equal(sdulid.ID[testID]{}))
+	})
+
+	It("should access the raw bytes", func() {
+		id := sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+
+		arr := id.Array()
+		Expect(arr[:]).To(Equal(id.Bytes()))
+		Expect(id.Bytes16()).To(Equal(&arr))
+
+		got, err := sdulid.FromBytes[testID](id.Bytes16()[:])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(got).To(Equal(id))
+
+		_, err = sdulid.FromBytes[otherID](arr[:])
+		Expect(err).To(MatchError(sdulid.ErrInvalidSuffix))
+		_, err = sdulid.FromBytes[testID](arr[:8])
+		Expect(err).To(MatchError(ulid.ErrDataSize))
+	})
+
+	It("should access the entropy without the suffix", func() {
+		id := sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+		Expect(id.Entropy()).To(Equal([8]byte{80, 16, 87, 251, 194, 161, 255, 222}))
+
+		Expect(id.SetEntropy([]byte{1, 2, 3, 4, 5, 6, 7, 8})).To(Succeed())
+		Expect(id.Entropy()).To(Equal([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+		Expect(id.Bytes()[14:]).To(Equal([]byte{255, 255}))
+		Expect(id.Time()).To(Equal(sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00").Time()))
+
+		Expect(id.SetEntropy(make([]byte, 10))).To(MatchError(ulid.ErrDataSize))
+		Expect(id.Entropy()).To(Equal([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+	})
+})
@@ -0,0 +1,51 @@
+package sdulid
+
+import "errors"
+
+// checkSymbols are the 37 Crockford base32 check symbols: the 32 encoding symbols followed by the
+// five symbols that are only used for checking.
+const checkSymbols = "0123456789ABCDEFGHJKMNPQRSTVWXYZ*~$=U"
+
+// ErrInvalidCheck is returned when the check symbol of a checked text form is missing or doesn't match.
+var ErrInvalidCheck = errors.New("sdulid: invalid check symbol")
+
+// CheckedString returns the prefixed text form followed by a Crockford check symbol. The symbol
+// catches any single-character typo and the transposition of adjacent characters, which is useful
+// for IDs that are read over the phone or typed over from invoices. Parse it with ParseChecked.
+func (id ID[T]) CheckedString() string {
+	dst, _ := id.AppendText(make([]byte, 0, id.EncodedSize()+1))
+
+	return string(append(dst, checkSymbols[checkValue(id)]))
+}
+
+// ParseChecked parses the text form that is returned by CheckedString, it returns ErrInvalidCheck
+// when the check symbol doesn't match. Like the rest of the text form the symbol is case-insensitive.
+func ParseChecked[T Kind](s string) (id ID[T], err error) {
+	if len(s) == 0 {
+		return id, ErrInvalidCheck
+	}
+
+	if err := id.UnmarshalText([]byte(s[:len(s)-1])); err != nil {
+		return id, err
+	}
+
+	sym := s[len(s)-1]
+	if sym >= 'a' && sym <= 'z' {
+		sym -= 'a' - 'A'
+	}
+
+	if sym != checkSymbols[checkValue(id)] {
+		return ID[T]{}, ErrInvalidCheck
+	}
+
+	return id, nil
+}
+
+// checkValue returns the 128 bit value of the id modulo 37.
+func checkValue[T Kind](id ID[T]) (r int) {
+	for _, b := range id.ULID {
+		r = (r<<8 | int(b)) % len(checkSymbols)
+	}
+
+	return r
+}
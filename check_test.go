@@ -0,0 +1,62 @@
+package sdulid_test
+
+import (
+	"strings"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("check symbol", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should append and validate the check symbol", func() {
+		checked := id1.CheckedString()
+		Expect(checked).To(HavePrefix("tst_01JBRQS1J5A085FYY2M7ZXXZ"))
+		Expect(checked).To(HaveLen(len("tst_01JBRQS1J5A085FYY2M7ZXXZ") + 1))
+
+		id2, err := sdulid.ParseChecked[testID](checked)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(id2).To(Equal(id1))
+
+		id3, err := sdulid.ParseChecked[testID](strings.ToLower(checked))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(id3).To(Equal(id1))
+	})
+
+	It("should catch single character typos", func() {
+		checked := id1.CheckedString()
+		alphabet := "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+		// every substitution in the timestamp and entropy characters, except in the last two since
+		// those also hold the suffix bits and are rejected by parsing anyway.
+		for i := len("tst_"); i < len(checked)-3; i++ {
+			for _, c := range alphabet {
+				if byte(c) == checked[i] || (i == len("tst_") && c > '7') {
+					continue
+				}
+
+				typo := checked[:i] + string(c) + checked[i+1:]
+				_, err := sdulid.ParseChecked[testID](typo)
+				Expect(err).To(MatchError(sdulid.ErrInvalidCheck), typo)
+			}
+		}
+	})
+
+	It("should reject missing or invalid symbols", func() {
+		_, err := sdulid.ParseChecked[testID]("")
+		Expect(err).To(MatchError(sdulid.ErrInvalidCheck))
+
+		_, err = sdulid.ParseChecked[testID]("tst_01JBRQS1J5A085FYY2M7ZXXZ")
+		Expect(err).To(HaveOccurred())
+
+		checked := id1.CheckedString()
+		_, err = sdulid.ParseChecked[testID](checked[:len(checked)-1] + "!")
+		Expect(err).To(MatchError(sdulid.ErrInvalidCheck))
+	})
+})
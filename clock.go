@@ -0,0 +1,72 @@
+package sdulid
+
+import (
+	"errors"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// ErrClockSkew is returned by a maker with a skew guard when the clock went backwards by more than
+// the configured threshold.
+var ErrClockSkew = errors.New("sdulid: clock went backwards")
+
+// Clock provides the current time to makers, so it can be controlled in tests or replaced by a clock
+// that is better behaved than the wall clock.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the Clock that returns the wall clock time.
+type SystemClock struct{}
+
+// Now returns the current wall clock time.
+func (SystemClock) Now() time.Time { return time.Now() }
+
+// WithClock generates the ID with the current time of c instead of the wall clock. WithTime and
+// WithTimestampMS take precedence.
+func WithClock(c Clock) Option {
+	return func(o *options) { o.clock = c }
+}
+
+// MonotonicOption configures a MonotonicMaker.
+type MonotonicOption func(*monotonicOptions)
+
+type monotonicOptions struct {
+	clock         Clock
+	skewThreshold time.Duration
+	skewGuard     bool
+	onSkew        func(skew time.Duration)
+	hook          Hook
+}
+
+// WithMonotonicClock makes the monotonic maker read the time from c instead of the wall clock.
+func WithMonotonicClock(c Clock) MonotonicOption {
+	return func(o *monotonicOptions) { o.clock = c }
+}
+
+// WithSkewGuard guards the monotonic maker against a clock that goes backwards by more than threshold,
+// as can happen on VMs with aggressive NTP stepping. When onSkew is nil, the maker refuses to generate
+// IDs with ErrClockSkew until the clock caught up. Otherwise onSkew is called once with how far the clock
+// went back, e.g. to log a warning, and the maker continues with the timestamp of the previous ID. When
+// the clock caught up, onSkew is called once more with a zero skew, so every episode is reported once
+// on entry and once on recovery rather than for every ID in between.
+func WithSkewGuard(threshold time.Duration, onSkew func(skew time.Duration)) MonotonicOption {
+	return func(o *monotonicOptions) {
+		o.skewGuard, o.skewThreshold, o.onSkew = true, threshold, onSkew
+	}
+}
+
+// WithHook calls h for every ID generated by the monotonic maker and for every overflow.
+func WithHook(h Hook) MonotonicOption {
+	return func(o *monotonicOptions) { o.hook = h }
+}
+
+// now returns the current time of the clock in Unix milliseconds, or of the wall clock if c is nil.
+func now(c Clock) uint64 {
+	if c == nil {
+		return ulid.Now()
+	}
+
+	return ulid.Timestamp(c.Now())
+}
@@ -0,0 +1,86 @@
+package sdulid_test
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeClock is a clock that returns a fixed time that can be moved around.
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) Now() time.Time { return c.t }
+
+var _ = Describe("clock", func() {
+	var clock *fakeClock
+	BeforeEach(func() {
+		clock = &fakeClock{t: time.UnixMilli(1730628322885)}
+	})
+
+	It("should generate ids with the time of the clock", func() {
+		Expect(sdulid.Make[testID](sdulid.WithClock(clock)).Timestamp()).To(Equal(uint64(1730628322885)))
+		Expect(sdulid.CryptoMaker[testID]{Clock: clock}.Make().Timestamp()).To(Equal(uint64(1730628322885)))
+		Expect(sdulid.NewMonotonicMaker[testID](nil, 0, sdulid.WithMonotonicClock(clock)).Make().Timestamp()).
+			To(Equal(uint64(1730628322885)))
+
+		ids, err := sdulid.NewBatch[testID](2, sdulid.WithClock(clock))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ids[1].Timestamp()).To(Equal(uint64(1730628322885)))
+		Expect(sdulid.CryptoMaker[testID]{Clock: clock}.MakeBatch(2)[1].Timestamp()).To(Equal(uint64(1730628322885)))
+
+		sharded := must(sdulid.NewShardedMaker[testID](4, 9))
+		sharded.Clock = clock
+		Expect(sharded.Make().Timestamp()).To(Equal(uint64(1730628322885)))
+		Expect(sharded.MakeBatch(2)[1].Timestamp()).To(Equal(uint64(1730628322885)))
+
+		Expect(sdulid.Make[testID](sdulid.WithClock(clock), sdulid.WithTimestampMS(1)).Timestamp()).To(Equal(uint64(1)))
+	})
+
+	It("should refuse when the clock goes back too far", func() {
+		mkr := sdulid.NewMonotonicMaker[testID](nil, 0,
+			sdulid.WithMonotonicClock(clock), sdulid.WithSkewGuard(time.Second, nil))
+		first := mkr.Make()
+
+		clock.t = clock.t.Add(-time.Second)
+		second := mkr.Make()
+		Expect(second.Timestamp()).To(Equal(first.Timestamp()))
+
+		clock.t = clock.t.Add(-time.Millisecond)
+		_, err := mkr.New()
+		Expect(err).To(MatchError(sdulid.ErrClockSkew))
+		Expect(err).To(MatchError(ContainSubstring("by 1.001s")))
+
+		clock.t = clock.t.Add(2 * time.Second)
+		Expect(bytes.Compare(mkr.Make().Bytes(), second.Bytes())).To(Equal(1))
+	})
+
+	It("should warn when the clock goes back too far", func() {
+		var skews []time.Duration
+		mkr := sdulid.NewMonotonicMaker[testID](nil, 0, sdulid.WithMonotonicClock(clock),
+			sdulid.WithSkewGuard(time.Second, func(skew time.Duration) { skews = append(skews, skew) }))
+		first := mkr.Make()
+
+		clock.t = clock.t.Add(-time.Minute)
+		second := mkr.Make()
+		Expect(skews).To(Equal([]time.Duration{time.Minute}))
+		Expect(second.Timestamp()).To(Equal(first.Timestamp()))
+		Expect(bytes.Compare(second.Bytes(), first.Bytes())).To(Equal(1))
+
+		clock.t = clock.t.Add(time.Second)
+		mkr.Make()
+		mkr.MakeBatch(10)
+		Expect(skews).To(Equal([]time.Duration{time.Minute}))
+
+		clock.t = clock.t.Add(time.Minute)
+		mkr.Make()
+		mkr.Make()
+		Expect(skews).To(Equal([]time.Duration{time.Minute, 0}))
+
+		clock.t = clock.t.Add(-2 * time.Minute)
+		mkr.Make()
+		Expect(skews).To(Equal([]time.Duration{time.Minute, 0, 2 * time.Minute}))
+	})
+})
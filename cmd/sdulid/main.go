@@ -0,0 +1,346 @@
+// Package main provides the sdulid command line tool for inspecting, generating and converting IDs.
+// The kinds are read from the same manifest that the sdulidgen code generator uses.
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidmanifest"
+	"github.com/oklog/ulid/v2"
+)
+
+const usage = `Usage: sdulid <command> [flags] [args]
+
+Commands:
+  inspect <id>...                   print the kind, time and entropy of each id
+  gen -kind <short_ident> [-count n] generate new ids
+  convert -to <form> <id>...        convert ids to the long, short, uuid or hex form
+  manifest export                   print the kinds as a canonical JSON manifest
+  manifest diff <old> <new>         report (breaking) changes between two manifests, exported or YAML
+
+Ids are accepted in any of the forms, pass - to read newline-delimited ids from stdin. Each command reads the kinds from the manifest passed
+with -manifest, which defaults to $SDULID_MANIFEST or kinds.yaml.
+`
+
+var (
+	errUsage       = errors.New("invalid usage")
+	errUnknownForm = errors.New("unknown form")
+	errBreaking    = errors.New("breaking changes")
+)
+
+// forms maps the name of each form to the function that formats an id in that form.
+var forms = map[string]func(id sdulid.AnyID) string{
+	"long":  func(id sdulid.AnyID) string { return id.ULID().String() },
+	"short": sdulid.AnyID.String,
+	"hex":   func(id sdulid.AnyID) string { return hex.EncodeToString(id.Bytes()) },
+	"uuid": func(id sdulid.AnyID) string {
+		h := hex.EncodeToString(id.Bytes())
+
+		return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:32]
+	},
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		if errors.Is(err, errUsage) {
+			fmt.Fprint(os.Stderr, usage)
+		}
+
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, r io.Reader, w io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: no command", errUsage)
+	}
+
+	// buffer the output since it may be large when ids are streamed from stdin.
+	bw := bufio.NewWriter(w)
+
+	var err error
+
+	switch args[0] {
+	case "inspect":
+		err = inspect(args[1:], r, bw)
+	case "gen":
+		err = gen(args[1:], bw)
+	case "convert":
+		err = convert(args[1:], r, bw)
+	case "manifest":
+		err = manifest(args[1:], bw)
+	default:
+		return fmt.Errorf("%w: unknown command %q", errUsage, args[0])
+	}
+
+	return errors.Join(err, bw.Flush())
+}
+
+// command holds the flags that all commands share.
+type command struct {
+	*flag.FlagSet
+	manifest string
+}
+
+func newCommand(name string) *command {
+	cmd := &command{FlagSet: flag.NewFlagSet(name, flag.ContinueOnError)}
+
+	manifest := os.Getenv("SDULID_MANIFEST")
+	if manifest == "" {
+		manifest = "kinds.yaml"
+	}
+
+	cmd.StringVar(&cmd.manifest, "manifest", manifest, "YAML (or JSON) manifest describing the kinds")
+
+	return cmd
+}
+
+// parse parses the arguments and reads the registry from the manifest.
+func (cmd *command) parse(args []string) (*sdulid.Registry, error) {
+	if err := cmd.Parse(args); err != nil {
+		return nil, fmt.Errorf("%w: %w", errUsage, err)
+	}
+
+	manifest, err := sdulidmanifest.ReadFile(cmd.manifest)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return manifest.Registry() //nolint:wrapcheck
+}
+
+// parseID parses s in the prefixed, long, uuid or hex form.
+func parseID(reg *sdulid.Registry, s string) (sdulid.AnyID, error) {
+	if !strings.Contains(s, "_") && (len(s) == 32 || len(s) == 36) { //nolint:mnd
+		b, err := hex.DecodeString(strings.ReplaceAll(s, "-", ""))
+		if err != nil || len(b) != len(ulid.ULID{}) {
+			return sdulid.AnyID{}, fmt.Errorf("invalid uuid or hex id %q", s)
+		}
+
+		s = ulid.ULID(b).String()
+	}
+
+	id, _, err := reg.ParseAny(s)
+	if err != nil {
+		return id, fmt.Errorf("failed to parse %q: %w", s, err)
+	}
+
+	return id, nil
+}
+
+// each parses every id and calls fn with it. If the only id is "-", the ids are read from r with
+// one id per line, empty lines are skipped.
+func each(reg *sdulid.Registry, ids []string, r io.Reader, fn func(id sdulid.AnyID) error) error {
+	if len(ids) == 0 {
+		return fmt.Errorf("%w: no ids", errUsage)
+	}
+
+	if len(ids) == 1 && ids[0] == "-" {
+		return eachLine(reg, r, fn)
+	}
+
+	for _, s := range ids {
+		id, err := parseID(reg, s)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// eachLine parses the id on every line of r and calls fn with it.
+func eachLine(reg *sdulid.Registry, r io.Reader, fn func(id sdulid.AnyID) error) error {
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		s := strings.TrimSpace(scanner.Text())
+		if s == "" {
+			continue
+		}
+
+		id, err := parseID(reg, s)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+
+		if err := fn(id); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err() //nolint:wrapcheck
+}
+
+func inspect(args []string, r io.Reader, w io.Writer) error {
+	cmd := newCommand("inspect")
+
+	reg, err := cmd.parse(args)
+	if err != nil {
+		return err
+	}
+
+	return each(reg, cmd.Args(), r, func(id sdulid.AnyID) error {
+		kind := id.Kind()
+
+		_, err := fmt.Fprintf(w, "%s kind=%s number=%d time=%s entropy=%x\n",
+			id, kind.KindIdent(), kind.KindNumber(),
+			id.Time().UTC().Format(time.RFC3339Nano), id.Bytes()[6:14])
+
+		return err //nolint:wrapcheck
+	})
+}
+
+func gen(args []string, w io.Writer) error {
+	cmd := newCommand("gen")
+	shortIdent := cmd.String("kind", "", "short ident of the kind to generate ids for")
+	count := cmd.Int("count", 1, "number of ids to generate")
+
+	reg, err := cmd.parse(args)
+	if err != nil {
+		return err
+	}
+
+	kind, ok := reg.LookupShortIdent(*shortIdent)
+	if !ok {
+		return fmt.Errorf("%w: %q", sdulid.ErrUnknownKind, *shortIdent)
+	}
+
+	for range *count {
+		id, err := sdulid.NewFor(kind)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		if _, err := fmt.Fprintln(w, id); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	return nil
+}
+
+func convert(args []string, r io.Reader, w io.Writer) error {
+	cmd := newCommand("convert")
+	to := cmd.String("to", "short", "form to convert to: long, short, uuid or hex")
+
+	reg, err := cmd.parse(args)
+	if err != nil {
+		return err
+	}
+
+	format, ok := forms[*to]
+	if !ok {
+		return fmt.Errorf("%w: %q", errUnknownForm, *to)
+	}
+
+	return each(reg, cmd.Args(), r, func(id sdulid.AnyID) error {
+		_, err := fmt.Fprintln(w, format(id))
+
+		return err //nolint:wrapcheck
+	})
+}
+
+func manifest(args []string, w io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: no manifest command", errUsage)
+	}
+
+	switch args[0] {
+	case "export":
+		return exportManifest(args[1:], w)
+	case "diff":
+		return diffManifests(args[1:], w)
+	default:
+		return fmt.Errorf("%w: unknown manifest command %q", errUsage, args[0])
+	}
+}
+
+func exportManifest(args []string, w io.Writer) error {
+	cmd := newCommand("manifest export")
+
+	reg, err := cmd.parse(args)
+	if err != nil {
+		return err
+	}
+
+	data, err := sdulid.ExportManifest(reg)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	_, err = w.Write(data)
+
+	return err //nolint:wrapcheck
+}
+
+// readRegistry reads the kinds from a manifest that was exported with "manifest export", or from a
+// manifest with entities as read by the sdulidmanifest package.
+func readRegistry(data []byte) (*sdulid.Registry, error) {
+	var exported struct {
+		Kinds json.RawMessage `json:"kinds"`
+	}
+
+	if json.Unmarshal(data, &exported) == nil && exported.Kinds != nil {
+		return sdulid.ImportManifest(data) //nolint:wrapcheck
+	}
+
+	manifest, err := sdulidmanifest.Parse(data)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return manifest.Registry() //nolint:wrapcheck
+}
+
+func diffManifests(args []string, w io.Writer) error {
+	if len(args) != 2 { //nolint:mnd
+		return fmt.Errorf("%w: expected the old and new manifest", errUsage)
+	}
+
+	var manifests [2]*sdulid.KindManifest
+	for i, name := range args {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return fmt.Errorf("error reading manifest: %w", err)
+		}
+
+		reg, err := readRegistry(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		manifests[i] = reg.Manifest()
+	}
+
+	var breaking int
+	for _, change := range sdulid.DiffManifests(manifests[0], manifests[1]) {
+		if change.Breaking {
+			breaking++
+		}
+
+		if _, err := fmt.Fprintln(w, change); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	if breaking > 0 {
+		return fmt.Errorf("%w: %d found", errBreaking, breaking)
+	}
+
+	return nil
+}
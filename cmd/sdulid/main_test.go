@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSdulid(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cmd/sdulid")
+}
+
+var _ = Describe("cli", func() {
+	var manifest string
+	var out *bytes.Buffer
+
+	BeforeEach(func() {
+		manifest = filepath.Join(GinkgoT().TempDir(), "kinds.yaml")
+		Expect(os.WriteFile(manifest, []byte(`
+entities:
+  - {name: Account, short_ident: acc, number: 1}
+  - {name: Test, short_ident: tst, number: 65535}
+`), 0o600)).To(Succeed())
+
+		out = bytes.NewBuffer(nil)
+	})
+
+	It("should inspect ids", func() {
+		Expect(run([]string{"inspect", "-manifest", manifest,
+			"tst_01JBRQS1J5A085FYY2M7ZXXZ", "01JBRQS1J5A085FYY2M7ZXW001"}, nil, out)).To(Succeed())
+		Expect(out.String()).To(Equal(
+			"tst_01JBRQS1J5A085FYY2M7ZXXZ kind=test number=65535 time=2024-11-03T10:05:22.885Z entropy=501057fbc2a1ffde\n" +
+				"acc_01JBRQS1J5A085FYY2M7ZXW0 kind=account number=1 time=2024-11-03T10:05:22.885Z entropy=501057fbc2a1ffde\n"))
+	})
+
+	It("should generate ids", func() {
+		Expect(run([]string{"gen", "-manifest", manifest, "--kind", "acc", "--count", "3"}, nil, out)).To(Succeed())
+
+		lines := strings.Fields(out.String())
+		Expect(lines).To(HaveLen(3))
+
+		for _, line := range lines {
+			Expect(line).To(HavePrefix("acc_"))
+		}
+	})
+
+	DescribeTable("convert",
+		func(to, in, exp string) {
+			Expect(run([]string{"convert", "-manifest", manifest, "-to", to, in}, nil, out)).To(Succeed())
+			Expect(out.String()).To(Equal(exp + "\n"))
+		},
+		Entry("to long", "long", "acc_01JBRQS1J5A085FYY2M7ZXW0", "01JBRQS1J5A085FYY2M7ZXW001"),
+		Entry("to short", "short", "01JBRQS1J5A085FYY2M7ZXW001", "acc_01JBRQS1J5A085FYY2M7ZXW0"),
+		Entry("to uuid", "uuid", "acc_01JBRQS1J5A085FYY2M7ZXW0", "0192f17c-8645-5010-57fb-c2a1ffde0001"),
+		Entry("to hex", "hex", "acc_01JBRQS1J5A085FYY2M7ZXW0", "0192f17c8645501057fbc2a1ffde0001"),
+		Entry("from uuid", "short", "0192f17c-8645-5010-57fb-c2a1ffde0001", "acc_01JBRQS1J5A085FYY2M7ZXW0"),
+		Entry("from hex", "short", "0192f17c8645501057fbc2a1ffde0001", "acc_01JBRQS1J5A085FYY2M7ZXW0"),
+	)
+
+	It("should stream ids from stdin", func() {
+		in := strings.NewReader("tst_01JBRQS1J5A085FYY2M7ZXXZ\n\n  01JBRQS1J5A085FYY2M7ZXW001  \n")
+		Expect(run([]string{"convert", "-manifest", manifest, "--to", "uuid", "-"}, in, out)).To(Succeed())
+		Expect(out.String()).To(Equal("0192f17c-8645-5010-57fb-c2a1ffdeffff\n0192f17c-8645-5010-57fb-c2a1ffde0001\n"))
+
+		out.Reset()
+
+		in = strings.NewReader("acc_01JBRQS1J5A085FYY2M7ZXW0\n")
+		Expect(run([]string{"inspect", "-manifest", manifest, "-"}, in, out)).To(Succeed())
+		Expect(out.String()).To(HavePrefix("acc_01JBRQS1J5A085FYY2M7ZXW0 kind=account"))
+	})
+
+	It("should report the line of invalid ids from stdin", func() {
+		in := strings.NewReader("acc_01JBRQS1J5A085FYY2M7ZXW0\nacc_01JBRQS1J5A085FYY2M7ZXXZ\n")
+		err := run([]string{"convert", "-manifest", manifest, "-"}, in, out)
+		Expect(err).To(MatchError(sdulid.ErrInvalidSuffix))
+		Expect(err).To(MatchError(ContainSubstring("line 2:")))
+		Expect(out.String()).To(Equal("acc_01JBRQS1J5A085FYY2M7ZXW0\n"))
+	})
+
+	It("should export the manifest", func() {
+		Expect(run([]string{"manifest", "export", "-manifest", manifest}, nil, out)).To(Succeed())
+		Expect(out.String()).To(HavePrefix("{\n  \"kinds\": [\n    {\n      \"number\": 1,\n      \"ident\": \"account\""))
+	})
+
+	It("should diff manifests", func() {
+		dir := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "old.json"), []byte(`{"kinds": [
+			{"number": 1, "ident": "account", "short_ident": "acc"},
+			{"number": 2, "ident": "user", "short_ident": "usr"}]}`), 0o600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "new.json"), []byte(`{"kinds": [
+			{"number": 1, "ident": "account", "short_ident": "acc"},
+			{"number": 2, "ident": "user", "short_ident": "mbr", "aliases": ["usr"]},
+			{"number": 3, "ident": "order", "short_ident": "ord"}]}`), 0o600)).To(Succeed())
+
+		Expect(run([]string{"manifest", "diff",
+			filepath.Join(dir, "old.json"), filepath.Join(dir, "new.json")}, nil, out)).To(Succeed())
+		Expect(out.String()).To(Equal(
+			`ok: prefix of "user" changed from "usr" to "mbr", the old prefix is kept as an alias` + "\n" +
+				`ok: kind "order" added with number 3` + "\n"))
+
+		out.Reset()
+
+		err := run([]string{"manifest", "diff",
+			filepath.Join(dir, "new.json"), filepath.Join(dir, "old.json")}, nil, out)
+		Expect(err).To(MatchError(errBreaking))
+		Expect(err).To(MatchError(ContainSubstring("2 found")))
+		Expect(out.String()).To(ContainSubstring(`breaking: kind "order" with number 3 removed`))
+
+		Expect(run([]string{"manifest", "diff", filepath.Join(dir, "old.json")}, nil, out)).To(MatchError(errUsage))
+		Expect(run([]string{"manifest", "foo"}, nil, out)).To(MatchError(errUsage))
+	})
+
+	It("should diff manifests with entities", func() {
+		dir := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "new.yaml"), []byte(`
+entities:
+  - {name: Account, short_ident: acc, number: 1}
+  - {name: Order, short_ident: ord, number: 3}
+  - {name: Test, short_ident: tst, number: 65535}
+`), 0o600)).To(Succeed())
+
+		Expect(run([]string{"manifest", "diff", manifest, filepath.Join(dir, "new.yaml")}, nil, out)).To(Succeed())
+		Expect(out.String()).To(Equal(`ok: kind "order" added with number 3` + "\n"))
+
+		out.Reset()
+
+		Expect(os.WriteFile(filepath.Join(dir, "old.json"), []byte(`{"kinds": [
+			{"number": 1, "ident": "account", "short_ident": "acc"},
+			{"number": 2, "ident": "user", "short_ident": "usr"}]}`), 0o600)).To(Succeed())
+
+		err := run([]string{"manifest", "diff", filepath.Join(dir, "old.json"), filepath.Join(dir, "new.yaml")}, nil, out)
+		Expect(err).To(MatchError(errBreaking))
+		Expect(out.String()).To(ContainSubstring(`breaking: kind "user" with number 2 removed`))
+	})
+
+	It("should require a known command", func() {
+		Expect(run([]string{}, nil, out)).To(MatchError(errUsage))
+		Expect(run([]string{"foo"}, nil, out)).To(MatchError(errUsage))
+	})
+
+	DescribeTable("errors",
+		func(cmd string, args []string, expErr any) {
+			Expect(run(append([]string{cmd, "-manifest", manifest}, args...), nil, out)).To(MatchError(expErr))
+		},
+		Entry("no ids", "inspect", nil, errUsage),
+		Entry("unknown flag", "inspect", []string{"-foo"}, errUsage),
+		Entry("unknown form", "convert", []string{"-to", "foo", "acc_01JBRQS1J5A085FYY2M7ZXW0"}, errUnknownForm),
+		Entry("unknown kind", "gen", []string{"-kind", "foo"}, sdulid.ErrUnknownKind),
+		Entry("unknown prefix", "inspect", []string{"foo_01JBRQS1J5A085FYY2M7ZXW0"}, sdulid.ErrUnknownKind),
+		Entry("wrong suffix", "inspect", []string{"acc_01JBRQS1J5A085FYY2M7ZXXZ"}, sdulid.ErrInvalidSuffix),
+		Entry("invalid hex", "inspect", []string{"0192f17c8645501057fbc2a1ffde000x"}, ContainSubstring("invalid uuid or hex")),
+	)
+
+	It("should fail without a manifest", func() {
+		Expect(run([]string{"inspect", "-manifest", manifest + ".missing", "acc_01JBRQS1J5A085FYY2M7ZXW0"}, nil, out)).To(
+			MatchError(os.ErrNotExist))
+	})
+})
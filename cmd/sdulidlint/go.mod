@@ -0,0 +1,18 @@
+module github.com/advdv/sdulid/cmd/sdulidlint
+
+go 1.23.1
+
+require (
+	github.com/advdv/sdulid/sdulidlint v0.0.0-00010101000000-000000000000
+	golang.org/x/tools v0.26.0
+)
+
+require (
+	golang.org/x/mod v0.21.0 // indirect
+	golang.org/x/sync v0.9.0 // indirect
+)
+
+replace (
+	github.com/advdv/sdulid => ../../
+	github.com/advdv/sdulid/sdulidlint => ../../sdulidlint
+)
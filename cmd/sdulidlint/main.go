@@ -0,0 +1,11 @@
+// Package main provides the sdulidlint analyzer as a standalone command and as a go vet tool:
+//
+//	go vet -vettool=$(which sdulidlint) ./...
+package main
+
+import (
+	"github.com/advdv/sdulid/sdulidlint"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() { singlechecker.Main(sdulidlint.Analyzer) }
@@ -0,0 +1,58 @@
+package sdulid
+
+import (
+	"errors"
+)
+
+// CBORTag is the tag number that IDs are tagged with when encoded as CBOR. It is the RFC 9562 binary
+// UUID tag since IDs are 128 bit values that are exchangeable as UUIDs (see ID.UUID).
+const CBORTag = 37
+
+var (
+	// ErrInvalidMsgpack is returned when msgpack data is not a 16 byte bin value.
+	ErrInvalidMsgpack = errors.New("sdulid: msgpack value must be a 16 byte bin")
+	// ErrInvalidCBOR is returned when CBOR data is not a (tagged) 16 byte byte string.
+	ErrInvalidCBOR = errors.New("sdulid: CBOR value must be a 16 byte byte string")
+)
+
+const (
+	msgpackBin8 = 0xc4 // msgpack bin 8 format
+	cborTag8    = 0xd8 // CBOR major type 6 (tag) with a 1 byte tag number
+	cborBytes16 = 0x50 // CBOR major type 2 (byte string) with a length of 16
+)
+
+// MarshalMsgpack implements the msgpack.Marshaler interface (github.com/vmihailenco/msgpack) by
+// encoding the id as a 16 byte bin value instead of its text form.
+func (id ID[T]) MarshalMsgpack() ([]byte, error) {
+	return append([]byte{msgpackBin8, byte(len(id.ULID))}, id.ULID[:]...), nil
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface. It requires a 16 byte bin value of
+// which the trailing two bytes describe T.
+func (id *ID[T]) UnmarshalMsgpack(data []byte) error {
+	if len(data) != 2+len(id.ULID) || data[0] != msgpackBin8 || int(data[1]) != len(id.ULID) {
+		return ErrInvalidMsgpack
+	}
+
+	return id.scanBytes(data[2:])
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface (github.com/fxamacker/cbor) by encoding the id
+// as a 16 byte byte string, tagged with CBORTag.
+func (id ID[T]) MarshalCBOR() ([]byte, error) {
+	return append([]byte{cborTag8, CBORTag, cborBytes16}, id.ULID[:]...), nil
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface. It accepts a 16 byte byte string that is
+// either untagged or tagged with CBORTag, of which the trailing two bytes describe T.
+func (id *ID[T]) UnmarshalCBOR(data []byte) error {
+	if len(data) == 3+len(id.ULID) && data[0] == cborTag8 && data[1] == CBORTag {
+		data = data[2:]
+	}
+
+	if len(data) != 1+len(id.ULID) || data[0] != cborBytes16 {
+		return ErrInvalidCBOR
+	}
+
+	return id.scanBytes(data[1:])
+}
@@ -0,0 +1,92 @@
+package sdulid_test
+
+import (
+	"github.com/advdv/sdulid"
+	"github.com/fxamacker/cbor/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+var _ = Describe("codec", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	Describe("msgpack", func() {
+		It("should encode as bin", func() {
+			data, err := msgpack.Marshal(id1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal(append([]byte{0xc4, 16}, id1.Bytes()...)))
+		})
+
+		It("should round trip in a struct", func() {
+			type event struct{ ID sdulid.ID[testID] }
+
+			data, err := msgpack.Marshal(event{ID: id1})
+			Expect(err).ToNot(HaveOccurred())
+
+			var ev event
+			Expect(msgpack.Unmarshal(data, &ev)).To(Succeed())
+			Expect(ev.ID).To(Equal(id1))
+		})
+
+		It("should verify the suffix and format", func() {
+			data, err := msgpack.Marshal(sdulid.MustFromULID[otherID]("01JBRQS1J5A085FYY2M7ZXWG00"))
+			Expect(err).ToNot(HaveOccurred())
+
+			var id2 sdulid.ID[testID]
+			Expect(msgpack.Unmarshal(data, &id2)).To(MatchError(sdulid.ErrInvalidSuffix))
+
+			data, err = msgpack.Marshal(id1.String())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(msgpack.Unmarshal(data, &id2)).To(MatchError(sdulid.ErrInvalidMsgpack))
+		})
+	})
+
+	Describe("cbor", func() {
+		It("should encode as tagged byte string", func() {
+			data, err := cbor.Marshal(id1)
+			Expect(err).ToNot(HaveOccurred())
+
+			var tag cbor.Tag
+			Expect(cbor.Unmarshal(data, &tag)).To(Succeed())
+			Expect(tag.Number).To(Equal(uint64(sdulid.CBORTag)))
+			Expect(tag.Content).To(Equal(id1.Bytes()))
+		})
+
+		It("should round trip in a struct", func() {
+			type event struct{ ID sdulid.ID[testID] }
+
+			data, err := cbor.Marshal(event{ID: id1})
+			Expect(err).ToNot(HaveOccurred())
+
+			var ev event
+			Expect(cbor.Unmarshal(data, &ev)).To(Succeed())
+			Expect(ev.ID).To(Equal(id1))
+		})
+
+		It("should decode untagged byte string", func() {
+			data, err := cbor.Marshal(id1.Bytes())
+			Expect(err).ToNot(HaveOccurred())
+
+			var id2 sdulid.ID[testID]
+			Expect(cbor.Unmarshal(data, &id2)).To(Succeed())
+			Expect(id2).To(Equal(id1))
+		})
+
+		It("should verify the suffix and format", func() {
+			data, err := cbor.Marshal(sdulid.MustFromULID[otherID]("01JBRQS1J5A085FYY2M7ZXWG00"))
+			Expect(err).ToNot(HaveOccurred())
+
+			var id2 sdulid.ID[testID]
+			Expect(cbor.Unmarshal(data, &id2)).To(MatchError(sdulid.ErrInvalidSuffix))
+
+			data, err = cbor.Marshal(id1.String())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cbor.Unmarshal(data, &id2)).To(MatchError(sdulid.ErrInvalidCBOR))
+		})
+	})
+})
@@ -0,0 +1,21 @@
+package sdulid
+
+import "context"
+
+// idContextKey is the context key of an ID of kind T, each kind has its own key.
+type idContextKey[T Kind] struct{}
+
+// ContextWithID returns a copy of ctx that carries id, so layers such as handlers, services and
+// repositories can pass along e.g. the current account. The key is typed by T, so a context carries at
+// most one ID per kind and IDs of different kinds never collide.
+func ContextWithID[T Kind](ctx context.Context, id ID[T]) context.Context {
+	return context.WithValue(ctx, idContextKey[T]{}, id)
+}
+
+// IDFromContext returns the ID of kind T that ctx carries. It returns false when ctx carries no ID of
+// kind T.
+func IDFromContext[T Kind](ctx context.Context) (ID[T], bool) {
+	id, ok := ctx.Value(idContextKey[T]{}).(ID[T])
+
+	return id, ok
+}
@@ -0,0 +1,36 @@
+package sdulid_test
+
+import (
+	"context"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("context", func() {
+	It("should carry one id per kind", func() {
+		id1, id2 := sdulid.Make[testID](), sdulid.Make[otherID]()
+
+		ctx := sdulid.ContextWithID(context.Background(), id1)
+		ctx = sdulid.ContextWithID(ctx, id2)
+
+		actual1, ok := sdulid.IDFromContext[testID](ctx)
+		Expect(ok).To(BeTrue())
+		Expect(actual1).To(Equal(id1))
+
+		actual2, ok := sdulid.IDFromContext[otherID](ctx)
+		Expect(ok).To(BeTrue())
+		Expect(actual2).To(Equal(id2))
+
+		id3 := sdulid.Make[testID]()
+		actual3, _ := sdulid.IDFromContext[testID](sdulid.ContextWithID(ctx, id3))
+		Expect(actual3).To(Equal(id3))
+	})
+
+	It("should report missing ids", func() {
+		id, ok := sdulid.IDFromContext[testID](context.Background())
+		Expect(ok).To(BeFalse())
+		Expect(id).To(Equal(sdulid.ID[testID]{}))
+	})
+})
@@ -0,0 +1,68 @@
+package sdulid
+
+import "fmt"
+
+// MarshalCSV implements the gocsv TypeMarshaller interface by returning the prefixed text form.
+func (id ID[T]) MarshalCSV() (string, error) {
+	return id.String(), nil
+}
+
+// UnmarshalCSV implements the gocsv TypeUnmarshaller interface. It accepts the prefixed and the long
+// text form.
+func (id *ID[T]) UnmarshalCSV(s string) error {
+	return id.UnmarshalText([]byte(s))
+}
+
+// MarshalCSV implements the gocsv TypeMarshaller interface, an empty field is returned when the NullID
+// is not valid.
+func (n NullID[T]) MarshalCSV() (string, error) {
+	if !n.Valid {
+		return "", nil
+	}
+
+	return n.ID.MarshalCSV()
+}
+
+// UnmarshalCSV implements the gocsv TypeUnmarshaller interface. An empty field results in an invalid
+// NullID.
+func (n *NullID[T]) UnmarshalCSV(s string) error {
+	if s == "" {
+		n.ID, n.Valid = ID[T]{}, false
+
+		return nil
+	}
+
+	if err := n.ID.UnmarshalCSV(s); err != nil {
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
+
+// MarshalCSVRecord appends the prefixed text form of ids to record, for writing with csv.Writer. Pass a
+// reused record to avoid allocating a new one for every row.
+func MarshalCSVRecord[T Kind](record []string, ids ...ID[T]) []string {
+	for _, id := range ids {
+		record = append(record, id.String())
+	}
+
+	return record
+}
+
+// UnmarshalCSVRecord appends the IDs of kind T in record, as read with csv.Reader, to ids. Every field
+// must hold an ID of kind T in the prefixed or long text form, the returned error names the first
+// field that doesn't.
+func UnmarshalCSVRecord[T Kind](ids []ID[T], record []string) ([]ID[T], error) {
+	for i, field := range record {
+		var id ID[T]
+		if err := id.UnmarshalCSV(field); err != nil {
+			return ids, fmt.Errorf("failed to decode field %d: %w", i, err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,51 @@
+package sdulid_test
+
+import (
+	"bytes"
+	"encoding/csv"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("csv", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should round-trip records", func() {
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		Expect(w.Write(sdulid.MarshalCSVRecord(nil, id1, id1))).To(Succeed())
+		w.Flush()
+		Expect(buf.String()).To(Equal("tst_01JBRQS1J5A085FYY2M7ZXXZ,tst_01JBRQS1J5A085FYY2M7ZXXZ\n"))
+
+		record, err := csv.NewReader(&buf).Read()
+		Expect(err).ToNot(HaveOccurred())
+
+		ids, err := sdulid.UnmarshalCSVRecord[testID](nil, record)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ids).To(Equal([]sdulid.ID[testID]{id1, id1}))
+	})
+
+	It("should verify the kind of every field", func() {
+		ids, err := sdulid.UnmarshalCSVRecord[testID](nil,
+			[]string{"tst_01JBRQS1J5A085FYY2M7ZXXZ", "oth_01JBRQS1J5A085FYY2M7ZXW0"})
+		Expect(err).To(MatchError(sdulid.ErrWrongKind))
+		Expect(err).To(MatchError(ContainSubstring("failed to decode field 1")))
+		Expect(ids).To(Equal([]sdulid.ID[testID]{id1}))
+	})
+
+	It("should marshal null ids as empty fields", func() {
+		var nid sdulid.NullID[testID]
+		Expect(nid.MarshalCSV()).To(Equal(""))
+		Expect(nid.UnmarshalCSV("tst_01JBRQS1J5A085FYY2M7ZXXZ")).To(Succeed())
+		Expect(nid).To(Equal(sdulid.NewNullID(id1)))
+		Expect(nid.MarshalCSV()).To(Equal("tst_01JBRQS1J5A085FYY2M7ZXXZ"))
+		Expect(nid.UnmarshalCSV("")).To(Succeed())
+		Expect(nid.Valid).To(BeFalse())
+	})
+})
@@ -0,0 +1,92 @@
+package sdulid
+
+import (
+	"crypto/hmac"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// ErrInvalidCursor is returned when a pagination cursor can't be decoded.
+var ErrInvalidCursor = errors.New("sdulid: invalid cursor")
+
+// cursorEncoding encodes cursors so they can be used in URLs without escaping.
+var cursorEncoding = base64.RawURLEncoding
+
+// EncodeCursor returns an opaque, URL-safe, cursor for keyset pagination that continues after id.
+// Since IDs are ordered by time, the ID of the last item on a page is all that is needed to fetch the
+// next page, see KeysetSQL.
+func EncodeCursor[T Kind](id ID[T]) string {
+	return cursorEncoding.EncodeToString(id.ULID[:])
+}
+
+// DecodeCursor decodes a cursor that was created by EncodeCursor. It returns ErrInvalidCursor if the
+// cursor is malformed or doesn't hold an ID of kind T.
+func DecodeCursor[T Kind](s string) (id ID[T], err error) {
+	var b [len(id.ULID)]byte
+	if cursorEncoding.DecodedLen(len(s)) != len(b) {
+		return id, ErrInvalidCursor
+	}
+
+	if _, err := cursorEncoding.Decode(b[:], []byte(s)); err != nil {
+		return id, ErrInvalidCursor
+	}
+
+	if err := id.Scan(b); err != nil {
+		return ID[T]{}, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	return id, nil
+}
+
+// EncodeSignedCursor is like EncodeCursor but appends a signature that is computed with key, so
+// clients can't craft cursors themselves.
+func EncodeSignedCursor[T Kind](key []byte, id ID[T]) string {
+	sig := signature(key, id.ULID)
+
+	return cursorEncoding.EncodeToString(append(id.ULID[:], sig[:]...))
+}
+
+// DecodeSignedCursor decodes a cursor that was created by EncodeSignedCursor. It returns
+// ErrInvalidSignature if the signature doesn't match.
+func DecodeSignedCursor[T Kind](key []byte, s string) (id ID[T], err error) {
+	var b [len(id.ULID) + SignatureSize]byte
+	if cursorEncoding.DecodedLen(len(s)) != len(b) {
+		return id, ErrInvalidCursor
+	}
+
+	if _, err := cursorEncoding.Decode(b[:], []byte(s)); err != nil {
+		return id, ErrInvalidCursor
+	}
+
+	u := ulid.ULID(b[:len(id.ULID)])
+	if exp := signature(key, u); !hmac.Equal(b[len(id.ULID):], exp[:]) {
+		return id, ErrInvalidSignature
+	}
+
+	if err := id.Scan(u[:]); err != nil {
+		return ID[T]{}, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	return id, nil
+}
+
+// KeysetSQL returns the SQL that selects the page after a cursor, to be appended to a SELECT statement.
+// The first parameter is the decoded cursor and the second the page size, e.g:
+//
+//	WHERE "id" > $1 ORDER BY "id" LIMIT $2
+//
+// With desc the page is selected in descending order, e.g. to show the newest items first. The first
+// page is selected by leaving out the WHERE clause.
+func KeysetSQL(column string, desc bool) string {
+	op, dir := ">", ""
+	if desc {
+		op, dir = "<", " DESC"
+	}
+
+	column = quoteSQLName(column)
+
+	return fmt.Sprintf("WHERE %s %s $1 ORDER BY %s%s LIMIT $2", column, op, column, dir)
+}
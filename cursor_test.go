@@ -0,0 +1,64 @@
+package sdulid_test
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("cursor", func() {
+	var id1 sdulid.ID[testID]
+
+	key := []byte("secret")
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should round trip", func() {
+		cur := sdulid.EncodeCursor(id1)
+		Expect(cur).To(HaveLen(22))
+		Expect(url.QueryEscape(cur)).To(Equal(cur))
+		Expect(sdulid.DecodeCursor[testID](cur)).To(Equal(id1))
+
+		for range 100 {
+			id2 := sdulid.Make[testID]()
+			Expect(sdulid.DecodeCursor[testID](sdulid.EncodeCursor(id2))).To(Equal(id2))
+		}
+	})
+
+	It("should reject invalid cursors", func() {
+		_, err := sdulid.DecodeCursor[testID]("foo")
+		Expect(err).To(MatchError(sdulid.ErrInvalidCursor))
+
+		_, err = sdulid.DecodeCursor[testID](strings.Repeat("!", 22))
+		Expect(err).To(MatchError(sdulid.ErrInvalidCursor))
+
+		_, err = sdulid.DecodeCursor[otherID](sdulid.EncodeCursor(id1))
+		Expect(err).To(MatchError(sdulid.ErrInvalidCursor))
+		Expect(err).To(MatchError(sdulid.ErrInvalidSuffix))
+	})
+
+	It("should round trip signed cursors", func() {
+		cur := sdulid.EncodeSignedCursor(key, id1)
+		Expect(url.QueryEscape(cur)).To(Equal(cur))
+		Expect(sdulid.DecodeSignedCursor[testID](key, cur)).To(Equal(id1))
+
+		_, err := sdulid.DecodeSignedCursor[testID]([]byte("other"), cur)
+		Expect(err).To(MatchError(sdulid.ErrInvalidSignature))
+
+		_, err = sdulid.DecodeSignedCursor[testID](key, sdulid.EncodeCursor(id1))
+		Expect(err).To(MatchError(sdulid.ErrInvalidCursor))
+
+		_, err = sdulid.DecodeSignedCursor[otherID](key, cur)
+		Expect(err).To(MatchError(sdulid.ErrInvalidSuffix))
+	})
+
+	It("should generate keyset sql", func() {
+		Expect(sdulid.KeysetSQL("id", false)).To(Equal(`WHERE "id" > $1 ORDER BY "id" LIMIT $2`))
+		Expect(sdulid.KeysetSQL("a.id", true)).To(Equal(`WHERE "a"."id" < $1 ORDER BY "a"."id" DESC LIMIT $2`))
+	})
+})
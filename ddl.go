@@ -0,0 +1,290 @@
+package sdulid
+
+import (
+	"fmt"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// CreateDomainSQL generates SQL for a PostgreSQL domain that constrains the ID
+// by checking the length and the 2-byte suffix for the entity type.
+func CreateDomainSQL[T Kind]() string {
+	var kind T
+
+	return CreateDomainSQLFor(kind)
+}
+
+// CreateDomainSQLFor is like CreateDomainSQL but for a kind that is only known at runtime.
+func CreateDomainSQLFor(kind Kind) string {
+	return fmt.Sprintf(`
+		CREATE DOMAIN %s_id AS bytea 
+		CHECK (
+			octet_length(VALUE) = 16 AND 
+			get_byte(VALUE, 14) = %d AND 
+			get_byte(VALUE, 15) = %d
+		)`,
+		kind.KindIdent(),
+		kind.KindNumber()>>8,   //nolint:mnd
+		kind.KindNumber()&0xFF, //nolint:mnd
+	)
+}
+
+// CreateDomainSQL8 is like CreateDomainSQL but for IDs with a 1-byte kind number, see ID8.
+func CreateDomainSQL8[T KindNarrow]() string {
+	var kind T
+
+	return fmt.Sprintf(`
+		CREATE DOMAIN %s_id AS bytea 
+		CHECK (
+			octet_length(VALUE) = 16 AND 
+			get_byte(VALUE, 15) = %d
+		)`,
+		kind.KindIdent(), kind.KindNumber())
+}
+
+// CreateDomainSQL24 is like CreateDomainSQL but for IDs with a 3-byte kind number, see ID24.
+func CreateDomainSQL24[T KindWide]() string {
+	var kind T
+
+	suffix := kindNumber24[T]()
+
+	return fmt.Sprintf(`
+		CREATE DOMAIN %s_id AS bytea 
+		CHECK (
+			octet_length(VALUE) = 16 AND 
+			get_byte(VALUE, 13) = %d AND 
+			get_byte(VALUE, 14) = %d AND 
+			get_byte(VALUE, 15) = %d
+		)`,
+		kind.KindIdent(), suffix[0], suffix[1], suffix[2])
+}
+
+// CreateGeneratorSQL returns the SQL for creating a PostgreSQL function for generating ULIDs in binary (BYTEA) format.
+// The last two bytes of the ULID will be set to the KindNumber in big-endian format.
+func CreateGeneratorSQL[T Kind]() string {
+	var kind T
+
+	return CreateGeneratorSQLFor(kind)
+}
+
+// CreateGeneratorSQLFor is like CreateGeneratorSQL but for a kind that is only known at runtime.
+func CreateGeneratorSQLFor(kind Kind) string {
+	kindNumber := kind.KindNumber()
+
+	return fmt.Sprintf(`CREATE FUNCTION generate_%s_id()
+	RETURNS BYTEA
+	AS $$
+	DECLARE
+		timestamp  BYTEA = E'\\000\\000\\000\\000\\000\\000';
+		kind_bytes BYTEA = E'\\000\\000';
+		unix_time  BIGINT;
+		ulid       BYTEA;
+	BEGIN
+		-- Generate the 6-byte timestamp
+		unix_time = (EXTRACT(EPOCH FROM CLOCK_TIMESTAMP()) * 1000)::BIGINT;
+		timestamp = SET_BYTE(timestamp, 0, (unix_time >> 40)::BIT(8)::INTEGER);
+		timestamp = SET_BYTE(timestamp, 1, (unix_time >> 32)::BIT(8)::INTEGER);
+		timestamp = SET_BYTE(timestamp, 2, (unix_time >> 24)::BIT(8)::INTEGER);
+		timestamp = SET_BYTE(timestamp, 3, (unix_time >> 16)::BIT(8)::INTEGER);
+		timestamp = SET_BYTE(timestamp, 4, (unix_time >> 8)::BIT(8)::INTEGER);
+		timestamp = SET_BYTE(timestamp, 5, unix_time::BIT(8)::INTEGER);
+
+		-- Generate 10 random bytes for entropy
+		ulid = timestamp || gen_random_bytes(8);
+
+		-- Set the last two bytes to the KindNumber in big-endian format
+		kind_bytes = SET_BYTE(kind_bytes, 0, (%d >> 8) & 255);  -- High byte (big-endian)
+		kind_bytes = SET_BYTE(kind_bytes, 1, %d & 255);          -- Low byte (big-endian)
+
+		-- Concatenate the ULID with the big-endian KindNumber bytes
+		ulid = ulid || kind_bytes;
+
+		RETURN ulid;
+	END
+	$$
+	LANGUAGE plpgsql
+	VOLATILE;`, kind.KindIdent(), kindNumber, kindNumber)
+}
+
+// CreateTextRenderSQL returns the SQL for creating a PostgreSQL function that renders an ID in its
+// prefixed text form, e.g. SELECT test_id_text(id) FROM ... returns 'tst_01JBRQS1J5A085FYY2M7ZXXZ'.
+// The function raises an exception when the value is not 16 bytes or holds another kind.
+func CreateTextRenderSQL[T Kind]() string {
+	var kind T
+
+	return CreateTextRenderSQLFor(kind)
+}
+
+// CreateTextRenderSQLFor is like CreateTextRenderSQL but for a kind that is only known at runtime.
+func CreateTextRenderSQLFor(kind Kind) string {
+	return fmt.Sprintf(`CREATE FUNCTION %[1]s_id_text(id BYTEA)
+	RETURNS TEXT
+	AS $$
+	DECLARE
+		alphabet TEXT = '%[2]s';
+		bits     BIT(130);
+		output   TEXT = '%[3]s_';
+	BEGIN
+		IF octet_length(id) <> 16 OR get_byte(id, 14) <> %[4]d OR get_byte(id, 15) <> %[5]d THEN
+			RAISE EXCEPTION 'sdulid: value is not a %[1]s id: %%', id;
+		END IF;
+
+		-- Prepend two zero bits so the 128 bits split evenly into 26 base32 characters
+		bits = B'00' || ('x' || encode(id, 'hex'))::BIT(128);
+
+		-- Only the first 24 characters are rendered, the kind suffix is implied by the prefix
+		FOR i IN 0..23 LOOP
+			output = output || substr(alphabet, substring(bits FROM i * 5 + 1 FOR 5)::INTEGER + 1, 1);
+		END LOOP;
+
+		RETURN output;
+	END
+	$$
+	LANGUAGE plpgsql
+	IMMUTABLE
+	STRICT;`,
+		kind.KindIdent(),
+		ulid.Encoding,
+		kind.KindShortIdent(),
+		kind.KindNumber()>>8,   //nolint:mnd
+		kind.KindNumber()&0xFF, //nolint:mnd
+	)
+}
+
+// CreateTimestampSQL returns the SQL for creating a PostgreSQL function that extracts the creation time
+// of an ID, e.g. SELECT test_id_ts(id) FROM ... returns a timestamptz with millisecond precision. The
+// function raises an exception when the value is not 16 bytes or holds another kind.
+func CreateTimestampSQL[T Kind]() string {
+	var kind T
+
+	return CreateTimestampSQLFor(kind)
+}
+
+// CreateTimestampSQLFor is like CreateTimestampSQL but for a kind that is only known at runtime.
+func CreateTimestampSQLFor(kind Kind) string {
+	return fmt.Sprintf(`CREATE FUNCTION %[1]s_id_ts(id BYTEA)
+	RETURNS TIMESTAMPTZ
+	AS $$
+	BEGIN
+		IF octet_length(id) <> 16 OR get_byte(id, 14) <> %[2]d OR get_byte(id, 15) <> %[3]d THEN
+			RAISE EXCEPTION 'sdulid: value is not a %[1]s id: %%', id;
+		END IF;
+
+		-- The first 6 bytes hold the Unix time in milliseconds
+		RETURN to_timestamp(('x' || encode(substring(id FROM 1 FOR 6), 'hex'))::BIT(48)::BIGINT / 1000.0);
+	END
+	$$
+	LANGUAGE plpgsql
+	IMMUTABLE
+	STRICT;`,
+		kind.KindIdent(),
+		kind.KindNumber()>>8,   //nolint:mnd
+		kind.KindNumber()&0xFF, //nolint:mnd
+	)
+}
+
+// CreateKindNumberSQL returns the SQL for creating the sdulid_kind(bytea) function that returns the kind
+// number of any ID. It allows auditing that columns hold the expected kinds, e.g:
+// SELECT sdulid_kind(id), count(*) FROM ... GROUP BY 1. The function raises an exception when the value
+// is not 16 bytes.
+func CreateKindNumberSQL() string {
+	return `CREATE FUNCTION sdulid_kind(id BYTEA)
+	RETURNS INTEGER
+	AS $$
+	BEGIN
+		IF octet_length(id) <> 16 THEN
+			RAISE EXCEPTION 'sdulid: value is not an id: %', id;
+		END IF;
+
+		RETURN get_byte(id, 14) << 8 | get_byte(id, 15);
+	END
+	$$
+	LANGUAGE plpgsql
+	IMMUTABLE
+	STRICT;`
+}
+
+// CreateTextParseSQL returns the SQL for creating a PostgreSQL function that parses the prefixed
+// text form back into the binary form, e.g. WHERE id = test_id_parse('tst_01JBRQS1J5A085FYY2M7ZXXZ').
+// It is the inverse of the function created by CreateTextRenderSQL and accepts lower case input.
+// The function raises an exception when the prefix, the characters or the implied suffix are invalid.
+func CreateTextParseSQL[T Kind]() string {
+	var kind T
+
+	return CreateTextParseSQLFor(kind)
+}
+
+// CreateTextParseSQLFor is like CreateTextParseSQL but for a kind that is only known at runtime.
+func CreateTextParseSQLFor(kind Kind) string {
+	prefix := kind.KindShortIdent() + "_"
+
+	return fmt.Sprintf(`CREATE FUNCTION %[1]s_id_parse(input TEXT)
+	RETURNS BYTEA
+	AS $$
+	DECLARE
+		alphabet TEXT = '%[2]s';
+		encoded  TEXT;
+		bits     BIT VARYING = B'';
+		pos      INTEGER;
+		id       BYTEA = '\x00000000000000000000000000000000'::BYTEA;
+	BEGIN
+		IF left(input, %[3]d) <> '%[4]s' OR length(input) <> %[5]d THEN
+			RAISE EXCEPTION 'sdulid: value is not a %[1]s id: %%', input;
+		END IF;
+
+		-- Decode the 24 base32 characters into 120 bits
+		encoded = upper(substr(input, %[6]d));
+		FOR i IN 1..24 LOOP
+			pos = strpos(alphabet, substr(encoded, i, 1));
+			IF pos = 0 OR (i = 1 AND pos > 8) THEN
+				RAISE EXCEPTION 'sdulid: invalid character in %[1]s id: %%', input;
+			END IF;
+
+			bits = bits || (pos - 1)::BIT(5);
+		END LOOP;
+
+		-- The trailing 6 bits hold the upper bits of the kind suffix
+		IF substring(bits FROM 115 FOR 6) <> B'%[7]s' THEN
+			RAISE EXCEPTION 'sdulid: value is not a %[1]s id: %%', input;
+		END IF;
+
+		-- Skip the two zero bits, take the 14 bytes of timestamp and entropy and re-attach the suffix
+		FOR i IN 0..13 LOOP
+			id = SET_BYTE(id, i, substring(bits FROM i * 8 + 3 FOR 8)::INTEGER);
+		END LOOP;
+
+		id = SET_BYTE(id, 14, %[8]d);
+		id = SET_BYTE(id, 15, %[9]d);
+
+		RETURN id;
+	END
+	$$
+	LANGUAGE plpgsql
+	IMMUTABLE
+	STRICT;`,
+		kind.KindIdent(),
+		ulid.Encoding,
+		len(prefix),
+		prefix,
+		len(prefix)+textSize,
+		len(prefix)+1,
+		fmt.Sprintf("%06b", kind.KindNumber()>>10), //nolint:mnd
+		kind.KindNumber()>>8,                       //nolint:mnd
+		kind.KindNumber()&0xFF,                     //nolint:mnd
+	)
+}
+
+// CreateColumnDefaultSQL returns the SQL that sets the function created by CreateGeneratorSQL as the
+// default of column, so rows inserted by raw SQL or ETL tools still get valid IDs of kind T. The table
+// may be schema qualified, e.g. "public.accounts".
+func CreateColumnDefaultSQL[T Kind](table, column string) string {
+	var kind T
+
+	return CreateColumnDefaultSQLFor(kind, table, column)
+}
+
+// CreateColumnDefaultSQLFor is like CreateColumnDefaultSQL but for a kind that is only known at runtime.
+func CreateColumnDefaultSQLFor(kind Kind, table, column string) string {
+	return fmt.Sprintf(`ALTER TABLE %s ALTER COLUMN %s SET DEFAULT generate_%s_id();`,
+		quoteSQLName(table), quoteSQL(column), kind.KindIdent())
+}
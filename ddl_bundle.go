@@ -0,0 +1,94 @@
+package sdulid
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// AllDDL returns a PostgreSQL migration for every kind in the registry. The up script creates the
+// sdulid_kind function and, for each kind, the domain, the generator function, the text render and parse
+// functions and the timestamp function. The down script drops all of them again in reverse order so it
+// can be run against the result of the up script. It doesn't cascade, so it fails while tables still use
+// the domains; use DropKindSQLFor with cascade to drop those columns as well.
+func AllDDL(reg *Registry) (up, down string) {
+	kindsUp, kindsDown := KindDDL(reg.Kinds()...)
+
+	return fmt.Sprintf("-- Code generated by sdulid; DO NOT EDIT.\n\n%s\n%s", CreateKindNumberSQL(), kindsUp),
+		fmt.Sprintf("-- Code generated by sdulid; DO NOT EDIT.\n%s\n%s\n",
+			kindsDown, dropStatement("FUNCTION", "sdulid_kind(BYTEA)", false))
+}
+
+// KindDDL returns the part of AllDDL that is specific to kinds, without the sdulid_kind function. It
+// allows kinds that are added later to be created by a migration of their own.
+func KindDDL(kinds ...Kind) (up, down string) {
+	var ub, db strings.Builder
+	for _, kind := range kinds {
+		fmt.Fprintf(&ub, "\n-- %s (%d)\n%s;\n\n%s\n\n%s\n\n%s\n\n%s\n",
+			kind.KindIdent(), kind.KindNumber(),
+			strings.TrimSpace(CreateDomainSQLFor(kind)),
+			CreateGeneratorSQLFor(kind),
+			CreateTextRenderSQLFor(kind),
+			CreateTextParseSQLFor(kind),
+			CreateTimestampSQLFor(kind))
+	}
+
+	for _, kind := range slices.Backward(kinds) {
+		fmt.Fprintf(&db, "\n-- %s (%d)\n%s\n", kind.KindIdent(), kind.KindNumber(), DropKindSQLFor(kind, false))
+	}
+
+	return ub.String(), db.String()
+}
+
+// DropDomainSQL returns the SQL for dropping the domain created by CreateDomainSQL. With cascade, the
+// columns that use the domain are dropped as well.
+func DropDomainSQL[T Kind](cascade bool) string {
+	var kind T
+
+	return DropDomainSQLFor(kind, cascade)
+}
+
+// DropDomainSQLFor is like DropDomainSQL but for a kind that is only known at runtime.
+func DropDomainSQLFor(kind Kind, cascade bool) string {
+	return dropStatement("DOMAIN", kind.KindIdent()+"_id", cascade)
+}
+
+// DropGeneratorSQL returns the SQL for dropping the function created by CreateGeneratorSQL. With cascade,
+// column defaults that call the function are dropped as well.
+func DropGeneratorSQL[T Kind](cascade bool) string {
+	var kind T
+
+	return DropGeneratorSQLFor(kind, cascade)
+}
+
+// DropGeneratorSQLFor is like DropGeneratorSQL but for a kind that is only known at runtime.
+func DropGeneratorSQLFor(kind Kind, cascade bool) string {
+	return dropStatement("FUNCTION", "generate_"+kind.KindIdent()+"_id()", cascade)
+}
+
+// DropKindSQL returns the SQL for dropping everything AllDDL creates for kind T, dependents first.
+// With cascade, objects that depend on them (such as columns and column defaults) are dropped as well.
+func DropKindSQL[T Kind](cascade bool) string {
+	var kind T
+
+	return DropKindSQLFor(kind, cascade)
+}
+
+// DropKindSQLFor is like DropKindSQL but for a kind that is only known at runtime.
+func DropKindSQLFor(kind Kind, cascade bool) string {
+	return strings.Join([]string{
+		dropStatement("FUNCTION", kind.KindIdent()+"_id_ts(BYTEA)", cascade),
+		dropStatement("FUNCTION", kind.KindIdent()+"_id_parse(TEXT)", cascade),
+		dropStatement("FUNCTION", kind.KindIdent()+"_id_text(BYTEA)", cascade),
+		DropGeneratorSQLFor(kind, cascade),
+		DropDomainSQLFor(kind, cascade),
+	}, "\n")
+}
+
+func dropStatement(typ, name string, cascade bool) string {
+	if cascade {
+		return fmt.Sprintf("DROP %s IF EXISTS %s CASCADE;", typ, name)
+	}
+
+	return fmt.Sprintf("DROP %s IF EXISTS %s;", typ, name)
+}
@@ -0,0 +1,93 @@
+package sdulid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dialect selects the SQL database that DDL is generated for.
+type Dialect int
+
+const (
+	// DialectPostgres generates DDL for PostgreSQL.
+	DialectPostgres Dialect = iota
+	// DialectMySQL generates DDL for MySQL (8.0.16+) and MariaDB (10.2+).
+	DialectMySQL
+	// DialectSQLite generates DDL for SQLite.
+	DialectSQLite
+	// DialectCockroachDB generates DDL for CockroachDB, which doesn't support domains.
+	DialectCockroachDB
+)
+
+func (d Dialect) String() string {
+	switch d {
+	case DialectPostgres:
+		return "postgres"
+	case DialectMySQL:
+		return "mysql"
+	case DialectSQLite:
+		return "sqlite"
+	case DialectCockroachDB:
+		return "cockroachdb"
+	default:
+		return fmt.Sprintf("Dialect(%d)", int(d))
+	}
+}
+
+// CreateColumnSQL returns a column definition for storing IDs of kind T in the given dialect. For
+// Postgres the column uses the domain from CreateDomainSQL, the other dialects use a raw binary
+// column with the CHECK expression from CreateCheckSQL. It panics for an unknown dialect.
+func CreateColumnSQL[T Kind](d Dialect, column string) string {
+	var kind T
+
+	switch d {
+	case DialectPostgres:
+		return fmt.Sprintf("%s %s_id NOT NULL", quoteSQL(column), kind.KindIdent())
+	case DialectMySQL:
+		return CreateMySQLColumnSQL[T](column)
+	case DialectSQLite:
+		return fmt.Sprintf("%s BLOB NOT NULL CHECK (%s)", quoteSQL(column), CreateCheckSQL[T](d, column))
+	case DialectCockroachDB:
+		return fmt.Sprintf("%s BYTES NOT NULL CHECK (%s)", quoteSQL(column), CreateCheckSQL[T](d, column))
+	default:
+		panic("sdulid: unsupported dialect: " + d.String())
+	}
+}
+
+// CreateCheckSQL returns a reusable CHECK expression that validates that column holds an ID of kind T
+// in the given dialect. This is the only way to constrain IDs in databases without domains, such as
+// CockroachDB. It panics for an unknown dialect.
+func CreateCheckSQL[T Kind](d Dialect, column string) string {
+	var kind T
+
+	switch d {
+	case DialectPostgres:
+		return fmt.Sprintf("octet_length(%[1]s) = 16 AND get_byte(%[1]s, 14) = %[2]d AND get_byte(%[1]s, 15) = %[3]d",
+			quoteSQL(column), kind.KindNumber()>>8, kind.KindNumber()&0xFF) //nolint:mnd
+	case DialectMySQL:
+		return CreateMySQLCheckSQL[T](column)
+	case DialectSQLite:
+		return fmt.Sprintf("typeof(%[1]s) = 'blob' AND length(%[1]s) = 16 AND hex(substr(%[1]s, 15, 2)) = '%04X'",
+			quoteSQL(column), kind.KindNumber())
+	case DialectCockroachDB:
+		return fmt.Sprintf("length(%[1]s) = 16 AND substring(%[1]s, 15, 2) = x'%04X'",
+			quoteSQL(column), kind.KindNumber())
+	default:
+		panic("sdulid: unsupported dialect: " + d.String())
+	}
+}
+
+// quoteSQL quotes an identifier using standard SQL double quotes.
+func quoteSQL(ident string) string {
+	return `"` + strings.ReplaceAll(ident, `"`, `""`) + `"`
+}
+
+// quoteSQLName quotes each part of a possibly schema qualified name.
+func quoteSQLName(name string) string {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		parts[i] = quoteSQL(part)
+	}
+
+	return strings.Join(parts, ".")
+}
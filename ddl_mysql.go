@@ -0,0 +1,27 @@
+package sdulid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CreateMySQLColumnSQL returns a MySQL column definition for storing IDs of kind T: a BINARY(16)
+// column with a CHECK constraint that validates the two trailing kind bytes. It is the MySQL
+// analogue of CreateDomainSQL. CHECK constraints are enforced by MySQL 8.0.16+ and MariaDB 10.2+.
+func CreateMySQLColumnSQL[T Kind](column string) string {
+	return fmt.Sprintf("%s BINARY(16) NOT NULL CHECK (%s)", quoteMySQL(column), CreateMySQLCheckSQL[T](column))
+}
+
+// CreateMySQLCheckSQL returns the MySQL CHECK expression that validates that column holds an ID of
+// kind T, for example to use with: ALTER TABLE ... ADD CONSTRAINT ... CHECK (...).
+func CreateMySQLCheckSQL[T Kind](column string) string {
+	var kind T
+
+	return fmt.Sprintf("OCTET_LENGTH(%[1]s) = 16 AND SUBSTRING(%[1]s, 15, 2) = X'%04X'",
+		quoteMySQL(column), kind.KindNumber())
+}
+
+// quoteMySQL quotes an identifier for MySQL.
+func quoteMySQL(ident string) string {
+	return "`" + strings.ReplaceAll(ident, "`", "``") + "`"
+}
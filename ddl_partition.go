@@ -0,0 +1,83 @@
+package sdulid
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// PartitionBound returns the smallest possible ID bytes for time t: the timestamp followed by zeros.
+// Since IDs start with their timestamp, a table that is range-partitioned on the ID column can use
+// these bounds to partition by creation time.
+func PartitionBound(t time.Time) []byte {
+	var u ulid.ULID
+	if err := u.SetTime(ulid.Timestamp(t)); err != nil {
+		panic(err)
+	}
+
+	return u[:]
+}
+
+// CreatePartitionSQL generates the SQL for creating the partitions of a table that is range-partitioned
+// on its primary key of kind T (i.e. created with "PARTITION BY RANGE (id)"). One partition is created
+// for each bucket of interval that overlaps with [from, to), bucket boundaries are aligned to multiples
+// of interval since the Unix epoch. Partitions are named after the table and the start of the bucket,
+// at the coarsest resolution that keeps the names unique: e.g. "accounts_p20241103" for daily,
+// "accounts_p20241103T1000" for hourly and "accounts_p20241103T100530" for 30 second partitions.
+// It panics when interval is less than a millisecond.
+func CreatePartitionSQL[T Kind](table string, from, to time.Time, interval time.Duration) string {
+	var kind T
+
+	if interval < time.Millisecond {
+		panic(fmt.Sprintf("sdulid: partition interval must be at least a millisecond, got: %s", interval))
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "-- partitions of %s by the creation time of %s ids\n", quoteSQLName(table), kind.KindIdent())
+
+	ms := interval.Milliseconds()
+	for start := time.UnixMilli(from.UnixMilli() / ms * ms).UTC(); start.Before(to); {
+		end := start.Add(interval)
+		fmt.Fprintf(&b, "CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM ('\\x%s') TO ('\\x%s');\n",
+			quoteSQLName(table+"_p"+partitionSuffix(start, interval)), quoteSQLName(table),
+			hex.EncodeToString(PartitionBound(start)), hex.EncodeToString(PartitionBound(end)))
+
+		start = end
+	}
+
+	return b.String()
+}
+
+// partitionSuffix formats the start of a bucket at the coarsest resolution that interval is a multiple
+// of, so that the names of consecutive buckets never collide.
+func partitionSuffix(start time.Time, interval time.Duration) string {
+	switch {
+	case interval%(24*time.Hour) == 0:
+		return start.Format("20060102")
+	case interval%time.Minute == 0:
+		return start.Format("20060102T1504")
+	case interval%time.Second == 0:
+		return start.Format("20060102T150405")
+	default:
+		return start.Format("20060102T150405") + fmt.Sprintf("%03d", start.Nanosecond()/int(time.Millisecond))
+	}
+}
+
+// CreatePartitionBoundSQL returns the SQL for creating the sdulid_partition_bound(timestamptz) function.
+// It computes the same bounds as PartitionBound so partitions can also be created from SQL, e.g. by a
+// scheduled job.
+func CreatePartitionBoundSQL() string {
+	return `CREATE FUNCTION sdulid_partition_bound(ts TIMESTAMPTZ)
+	RETURNS BYTEA
+	AS $$
+	BEGIN
+		RETURN decode(lpad(to_hex(floor(EXTRACT(EPOCH FROM ts) * 1000)::BIGINT), 12, '0'), 'hex') || '\x00000000000000000000'::BYTEA;
+	END
+	$$
+	LANGUAGE plpgsql
+	IMMUTABLE
+	STRICT;`
+}
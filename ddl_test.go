@@ -0,0 +1,192 @@
+package sdulid_test
+
+import (
+	"strings"
+	"time"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ddl", func() {
+	It("should generate domain sql for runtime kinds", func() {
+		Expect(sdulid.CreateDomainSQLFor(testID{})).To(Equal(sdulid.CreateDomainSQL[testID]()))
+		Expect(sdulid.CreateGeneratorSQLFor(otherID{})).To(Equal(sdulid.CreateGeneratorSQL[otherID]()))
+	})
+
+	It("should generate text render sql", func() {
+		sql := sdulid.CreateTextRenderSQL[otherID]()
+		Expect(sql).To(Equal(sdulid.CreateTextRenderSQLFor(otherID{})))
+		Expect(sql).To(HavePrefix("CREATE FUNCTION other_id_text(id BYTEA)"))
+		Expect(sql).To(ContainSubstring(`output   TEXT = 'oth_';`))
+		Expect(sql).To(ContainSubstring(`get_byte(id, 14) <> 0 OR get_byte(id, 15) <> 1`))
+		Expect(sql).To(ContainSubstring(`'0123456789ABCDEFGHJKMNPQRSTVWXYZ'`))
+		Expect(sql).To(ContainSubstring(`'sdulid: value is not a other id: %', id`))
+	})
+
+	It("should generate text parse sql", func() {
+		sql := sdulid.CreateTextParseSQL[testID]()
+		Expect(sql).To(Equal(sdulid.CreateTextParseSQLFor(testID{})))
+		Expect(sql).To(HavePrefix("CREATE FUNCTION test_id_parse(input TEXT)"))
+		Expect(sql).To(ContainSubstring(`IF left(input, 4) <> 'tst_' OR length(input) <> 28 THEN`))
+		Expect(sql).To(ContainSubstring(`encoded = upper(substr(input, 5));`))
+		Expect(sql).To(ContainSubstring(`substring(bits FROM 115 FOR 6) <> B'111111'`))
+		Expect(sql).To(ContainSubstring("id = SET_BYTE(id, 14, 255);\n\t\tid = SET_BYTE(id, 15, 255);"))
+
+		Expect(sdulid.CreateTextParseSQL[otherID]()).To(ContainSubstring(`<> B'000000'`))
+	})
+
+	It("should generate timestamp and kind sql", func() {
+		sql := sdulid.CreateTimestampSQL[otherID]()
+		Expect(sql).To(Equal(sdulid.CreateTimestampSQLFor(otherID{})))
+		Expect(sql).To(HavePrefix("CREATE FUNCTION other_id_ts(id BYTEA)\n\tRETURNS TIMESTAMPTZ"))
+		Expect(sql).To(ContainSubstring(`get_byte(id, 14) <> 0 OR get_byte(id, 15) <> 1`))
+		Expect(sql).To(ContainSubstring(`::BIT(48)::BIGINT / 1000.0`))
+
+		Expect(sdulid.CreateKindNumberSQL()).To(ContainSubstring("RETURN get_byte(id, 14) << 8 | get_byte(id, 15);"))
+	})
+
+	It("should generate column default sql", func() {
+		Expect(sdulid.CreateColumnDefaultSQL[otherID]("public.accounts", "id")).To(Equal(
+			`ALTER TABLE "public"."accounts" ALTER COLUMN "id" SET DEFAULT generate_other_id();`))
+		Expect(sdulid.CreateColumnDefaultSQLFor(testID{}, "items", `i"d`)).To(Equal(
+			`ALTER TABLE "items" ALTER COLUMN "i""d" SET DEFAULT generate_test_id();`))
+	})
+
+	It("should generate drop sql", func() {
+		Expect(sdulid.DropDomainSQL[testID](false)).To(Equal("DROP DOMAIN IF EXISTS test_id;"))
+		Expect(sdulid.DropDomainSQLFor(otherID{}, true)).To(Equal("DROP DOMAIN IF EXISTS other_id CASCADE;"))
+		Expect(sdulid.DropGeneratorSQL[testID](false)).To(Equal("DROP FUNCTION IF EXISTS generate_test_id();"))
+		Expect(sdulid.DropGeneratorSQLFor(otherID{}, true)).To(Equal("DROP FUNCTION IF EXISTS generate_other_id() CASCADE;"))
+		Expect(sdulid.DropKindSQL[otherID](true)).To(Equal(`DROP FUNCTION IF EXISTS other_id_ts(BYTEA) CASCADE;
+DROP FUNCTION IF EXISTS other_id_parse(TEXT) CASCADE;
+DROP FUNCTION IF EXISTS other_id_text(BYTEA) CASCADE;
+DROP FUNCTION IF EXISTS generate_other_id() CASCADE;
+DROP DOMAIN IF EXISTS other_id CASCADE;`))
+	})
+
+	It("should generate ddl for all kinds in a registry", func() {
+		up, down := sdulid.AllDDL(sdulid.MustNewRegistry(testID{}, otherID{}))
+
+		Expect(up).To(HavePrefix("-- Code generated by sdulid; DO NOT EDIT.\n\nCREATE FUNCTION sdulid_kind(id BYTEA)"))
+		Expect(up).To(ContainSubstring("\n-- other (1)\nCREATE DOMAIN other_id AS bytea"))
+		Expect(up).To(ContainSubstring(sdulid.CreateTimestampSQL[testID]()))
+		Expect(up).To(ContainSubstring(sdulid.CreateGeneratorSQL[testID]()))
+		Expect(up).To(ContainSubstring(sdulid.CreateTextRenderSQL[otherID]()))
+		Expect(up).To(ContainSubstring(sdulid.CreateTextParseSQL[testID]()))
+		Expect(strings.Index(up, "CREATE DOMAIN other_id")).To(BeNumerically("<", strings.Index(up, "CREATE DOMAIN test_id")))
+
+		Expect(down).To(Equal(`-- Code generated by sdulid; DO NOT EDIT.
+
+-- test (65535)
+DROP FUNCTION IF EXISTS test_id_ts(BYTEA);
+DROP FUNCTION IF EXISTS test_id_parse(TEXT);
+DROP FUNCTION IF EXISTS test_id_text(BYTEA);
+DROP FUNCTION IF EXISTS generate_test_id();
+DROP DOMAIN IF EXISTS test_id;
+
+-- other (1)
+DROP FUNCTION IF EXISTS other_id_ts(BYTEA);
+DROP FUNCTION IF EXISTS other_id_parse(TEXT);
+DROP FUNCTION IF EXISTS other_id_text(BYTEA);
+DROP FUNCTION IF EXISTS generate_other_id();
+DROP DOMAIN IF EXISTS other_id;
+
+DROP FUNCTION IF EXISTS sdulid_kind(BYTEA);
+`))
+	})
+
+	It("should generate ddl for added kinds without the shared function", func() {
+		up, down := sdulid.KindDDL(otherID{})
+		allUp, allDown := sdulid.AllDDL(sdulid.MustNewRegistry(otherID{}))
+
+		Expect(up).ToNot(ContainSubstring("sdulid_kind"))
+		Expect(down).ToNot(ContainSubstring("sdulid_kind"))
+		Expect(allUp).To(HaveSuffix(up))
+		Expect(allDown).To(ContainSubstring(down))
+	})
+
+	Describe("partitions", func() {
+		It("should compute bounds from the timestamp", func() {
+			Expect(sdulid.PartitionBound(time.UnixMilli(1730628322885))).To(Equal(
+				[]byte{1, 146, 241, 124, 134, 69, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}))
+		})
+
+		It("should generate daily partitions", func() {
+			Expect(sdulid.CreatePartitionSQL[testID]("public.tests",
+				time.Date(2024, 11, 3, 10, 5, 0, 0, time.UTC), time.Date(2024, 11, 5, 0, 0, 0, 0, time.UTC), 24*time.Hour,
+			)).To(Equal(`-- partitions of "public"."tests" by the creation time of test ids
+CREATE TABLE IF NOT EXISTS "public"."tests_p20241103" PARTITION OF "public"."tests" FOR VALUES FROM ('\x0192ef52480000000000000000000000') TO ('\x0192f478a40000000000000000000000');
+CREATE TABLE IF NOT EXISTS "public"."tests_p20241104" PARTITION OF "public"."tests" FOR VALUES FROM ('\x0192f478a40000000000000000000000') TO ('\x0192f99f000000000000000000000000');
+`))
+		})
+
+		It("should name sub-day partitions by the minute", func() {
+			sql := sdulid.CreatePartitionSQL[testID]("tests",
+				time.Date(2024, 11, 3, 10, 5, 0, 0, time.UTC), time.Date(2024, 11, 3, 12, 0, 0, 0, time.UTC), time.Hour)
+			Expect(strings.Count(sql, "CREATE TABLE")).To(Equal(2))
+			Expect(sql).To(ContainSubstring(`"tests_p20241103T1000"`))
+			Expect(sql).To(ContainSubstring(`"tests_p20241103T1100"`))
+		})
+
+		DescribeTable("should name partitions uniquely at the interval's resolution",
+			func(interval time.Duration, exp ...string) {
+				from := time.Date(2024, 11, 3, 10, 5, 0, 0, time.UTC)
+				sql := sdulid.CreatePartitionSQL[testID]("tests", from, from.Add(2*interval), interval)
+				Expect(strings.Count(sql, "CREATE TABLE")).To(Equal(len(exp)))
+				for _, name := range exp {
+					Expect(sql).To(ContainSubstring(`"tests_p` + name + `"`))
+				}
+			},
+			Entry("30 seconds", 30*time.Second, "20241103T100500", "20241103T100530"),
+			Entry("90 seconds", 90*time.Second, "20241103T100430", "20241103T100600", "20241103T100730"),
+			Entry("250 milliseconds", 250*time.Millisecond, "20241103T100500000", "20241103T100500250"),
+		)
+
+		It("should panic on invalid intervals", func() {
+			Expect(func() {
+				sdulid.CreatePartitionSQL[testID]("tests", time.Now(), time.Now(), time.Microsecond)
+			}).To(PanicWith(ContainSubstring("at least a millisecond")))
+		})
+
+		It("should generate the bound function", func() {
+			Expect(sdulid.CreatePartitionBoundSQL()).To(ContainSubstring("sdulid_partition_bound(ts TIMESTAMPTZ)"))
+		})
+	})
+
+	Describe("mysql", func() {
+		It("should generate column sql", func() {
+			Expect(sdulid.CreateMySQLColumnSQL[otherID]("owner_id")).To(Equal(
+				"`owner_id` BINARY(16) NOT NULL CHECK (OCTET_LENGTH(`owner_id`) = 16 AND SUBSTRING(`owner_id`, 15, 2) = X'0001')"))
+		})
+
+		It("should generate check sql with quoted identifiers", func() {
+			Expect(sdulid.CreateMySQLCheckSQL[testID]("we`ird")).To(Equal(
+				"OCTET_LENGTH(`we``ird`) = 16 AND SUBSTRING(`we``ird`, 15, 2) = X'FFFF'"))
+		})
+	})
+
+	DescribeTable("dialect column sql",
+		func(d sdulid.Dialect, exp string) {
+			Expect(sdulid.CreateColumnSQL[otherID](d, "owner_id")).To(Equal(exp))
+		},
+		Entry("postgres", sdulid.DialectPostgres, `"owner_id" other_id NOT NULL`),
+		Entry("mysql", sdulid.DialectMySQL, sdulid.CreateMySQLColumnSQL[otherID]("owner_id")),
+		Entry("sqlite", sdulid.DialectSQLite, `"owner_id" BLOB NOT NULL CHECK (typeof("owner_id") = 'blob' AND `+
+			`length("owner_id") = 16 AND hex(substr("owner_id", 15, 2)) = '0001')`),
+		Entry("cockroachdb", sdulid.DialectCockroachDB, `"owner_id" BYTES NOT NULL CHECK (length("owner_id") = 16 AND `+
+			`substring("owner_id", 15, 2) = x'0001')`),
+	)
+
+	It("should generate postgres check sql", func() {
+		Expect(sdulid.CreateCheckSQL[testID](sdulid.DialectPostgres, `we"ird`)).To(Equal(
+			`octet_length("we""ird") = 16 AND get_byte("we""ird", 14) = 255 AND get_byte("we""ird", 15) = 255`))
+	})
+
+	It("should panic on unknown dialect", func() {
+		Expect(func() {
+			sdulid.CreateCheckSQL[testID](sdulid.Dialect(42), "id")
+		}).To(PanicWith("sdulid: unsupported dialect: Dialect(42)"))
+	})
+})
@@ -0,0 +1,183 @@
+package sdulid
+
+import (
+	"sync/atomic"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// ParseMode determines which characters are accepted when decoding the text forms of IDs.
+type ParseMode int32
+
+const (
+	// ParseModeDefault accepts the Crockford base32 alphabet in upper and lower case.
+	ParseModeDefault ParseMode = iota
+	// ParseModeLenient additionally accepts the Crockford confusables: I and L are decoded as 1 and O
+	// is decoded as 0, in upper and lower case. This is useful for IDs that are typed over by humans.
+	ParseModeLenient
+	// ParseModeStrict only accepts the canonical upper case alphabet.
+	ParseModeStrict
+)
+
+// parseMode holds the package-wide parse mode.
+var parseMode atomic.Int32
+
+// SetParseMode configures which characters are accepted when decoding IDs from text. Encoding always
+// produces the canonical upper case alphabet. It is safe for concurrent use but is meant to be called
+// once during program initialization.
+func SetParseMode(m ParseMode) {
+	parseMode.Store(int32(m))
+}
+
+// GetParseMode returns the currently configured parse mode.
+func GetParseMode() ParseMode {
+	return ParseMode(parseMode.Load())
+}
+
+// decStrict, decDefault and decLenient map the accepted characters of each parse mode to their 5 bit
+// value. All other characters map to 0xFF.
+var decStrict, decDefault, decLenient = func() (strict, def, lenient [256]byte) {
+	for i := range strict {
+		strict[i] = 0xFF
+	}
+
+	for i := range len(ulid.Encoding) {
+		strict[ulid.Encoding[i]] = byte(i)
+	}
+
+	def = strict
+	for i := range len(ulid.Encoding) {
+		def[ulid.Encoding[i]|0x20] = byte(i) // lower case, digits are unaffected
+	}
+
+	lenient = def
+	for c, v := range map[byte]byte{'I': 1, 'i': 1, 'L': 1, 'l': 1, 'O': 0, 'o': 0} {
+		lenient[c] = v
+	}
+
+	return strict, def, lenient
+}()
+
+// decoder returns the decoding table of the configured parse mode.
+func decoder() *[256]byte {
+	switch GetParseMode() {
+	case ParseModeLenient:
+		return &decLenient
+	case ParseModeStrict:
+		return &decStrict
+	default:
+		return &decDefault
+	}
+}
+
+// textSize is the size of the text form without the prefix: a ulid without the last two characters.
+const textSize = ulid.EncodedSize - 2
+
+// decodeText decodes the 24 characters of the text form (without prefix) into dst and sets the kind
+// suffix bytes. The text carries the upper bits of the first suffix byte, they must match the kind.
+func decodeText(dst *ulid.ULID, v []byte, kindNumber uint16) error {
+	if len(v) != textSize {
+		return ulid.ErrDataSize
+	}
+
+	dec := decoder()
+	if err := checkText(dec, v); err != nil {
+		return err
+	}
+
+	if err := checkSuffix(dec, v, kindNumber); err != nil {
+		return err
+	}
+
+	decodeTimeAndEntropy(dst, dec, v)
+
+	// 2 bytes kind suffix
+	dst[14], dst[15] = byte(kindNumber>>8), byte(kindNumber)
+
+	return nil
+}
+
+// checkSuffix checks that the last characters of the text form carry the upper bits of the first suffix
+// byte of kindNumber.
+func checkSuffix[S ~string | ~[]byte](dec *[256]byte, v S, kindNumber uint16) error {
+	if (dec[v[22]]<<7)|(dec[v[23]]<<2) != byte(kindNumber>>8)&0xFC {
+		return ErrInvalidSuffix
+	}
+
+	return nil
+}
+
+// checkText checks the first 24 characters of v for overflow and invalid characters.
+func checkText[S ~string | ~[]byte](dec *[256]byte, v S) error {
+	// The first character can't be larger than 7 since the base32 representation encodes 130 bits
+	// while a ULID is only 128 bits.
+	if dec[v[0]] > 7 && dec[v[0]] != 0xFF {
+		return ulid.ErrOverflow
+	}
+
+	// Since valid values only use the lower 5 bits, any invalid character (0xFF) shows up in the
+	// upper bits of the bitwise OR of all decoded values.
+	if (dec[v[0]]|dec[v[1]]|dec[v[2]]|dec[v[3]]|dec[v[4]]|dec[v[5]]|dec[v[6]]|dec[v[7]]|
+		dec[v[8]]|dec[v[9]]|dec[v[10]]|dec[v[11]]|dec[v[12]]|dec[v[13]]|dec[v[14]]|dec[v[15]]|
+		dec[v[16]]|dec[v[17]]|dec[v[18]]|dec[v[19]]|dec[v[20]]|dec[v[21]]|dec[v[22]]|dec[v[23]])&0xE0 != 0 {
+		return ulid.ErrInvalidCharacters
+	}
+
+	return nil
+}
+
+// decodeTimeAndEntropy decodes the first 24 characters of v into the first 14 bytes of dst.
+func decodeTimeAndEntropy(dst *ulid.ULID, dec *[256]byte, v []byte) {
+	// Optimized unrolled loop, mirrors the encoding in encodeText.
+	// 6 bytes timestamp (48 bits)
+	dst[0] = (dec[v[0]] << 5) | dec[v[1]]
+	dst[1] = (dec[v[2]] << 3) | (dec[v[3]] >> 2)
+	dst[2] = (dec[v[3]] << 6) | (dec[v[4]] << 1) | (dec[v[5]] >> 4)
+	dst[3] = (dec[v[5]] << 4) | (dec[v[6]] >> 1)
+	dst[4] = (dec[v[6]] << 7) | (dec[v[7]] << 2) | (dec[v[8]] >> 3)
+	dst[5] = (dec[v[8]] << 5) | dec[v[9]]
+
+	// 8 bytes of entropy (64 bits)
+	dst[6] = (dec[v[10]] << 3) | (dec[v[11]] >> 2)
+	dst[7] = (dec[v[11]] << 6) | (dec[v[12]] << 1) | (dec[v[13]] >> 4)
+	dst[8] = (dec[v[13]] << 4) | (dec[v[14]] >> 1)
+	dst[9] = (dec[v[14]] << 7) | (dec[v[15]] << 2) | (dec[v[16]] >> 3)
+	dst[10] = (dec[v[16]] << 5) | dec[v[17]]
+	dst[11] = (dec[v[18]] << 3) | dec[v[19]]>>2
+	dst[12] = (dec[v[19]] << 6) | (dec[v[20]] << 1) | (dec[v[21]] >> 4)
+	dst[13] = (dec[v[21]] << 4) | (dec[v[22]] >> 1)
+}
+
+// parseULID parses s like ulid.Parse, or like ulid.ParseStrict in ParseModeStrict. Unlike the other
+// decoding, FromULID has always accepted invalid characters outside the strict mode, so existing input
+// keeps parsing.
+func parseULID(s string) (ulid.ULID, error) {
+	if GetParseMode() == ParseModeStrict {
+		return ulid.ParseStrict(s) //nolint:wrapcheck
+	}
+
+	return ulid.Parse(s) //nolint:wrapcheck
+}
+
+// decodeLong decodes the 26 characters of the long form into dst. Every character is checked against
+// the configured parse mode and mapped to the canonical form first, since the lenient ulid decoding
+// silently accepts invalid characters. Like ulid.ParseStrict, a first character above '7' is rejected
+// with ulid.ErrOverflow in every parse mode.
+func decodeLong(dst *ulid.ULID, v []byte) error {
+	if len(v) != ulid.EncodedSize {
+		return ulid.ErrDataSize
+	}
+
+	dec := decoder()
+
+	var canonical [ulid.EncodedSize]byte
+	for i, c := range v {
+		if dec[c] == 0xFF {
+			return ulid.ErrInvalidCharacters
+		}
+
+		canonical[i] = ulid.Encoding[dec[c]]
+	}
+
+	return dst.UnmarshalText(canonical[:]) //nolint:wrapcheck
+}
@@ -0,0 +1,59 @@
+package sdulid
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"slices"
+	"time"
+)
+
+// NewDeterministic generates an ID of which the entropy is derived from data with a keyed hash
+// (HMAC-SHA256 with namespace as the key), similar to UUIDv5. The timestamp is the current time unless
+// WithTime or WithTimestampMS is provided, so importers should provide the time of the source record to
+// generate the same ID for the same record. WithEntropy has no effect.
+func NewDeterministic[T Kind](namespace, data []byte, opts ...Option) (ID[T], error) {
+	mac := hmac.New(sha256.New, namespace)
+	mac.Write(data)
+
+	return New[T](append(slices.Clip(opts), WithEntropy(bytes.NewReader(mac.Sum(nil))))...)
+}
+
+// MakeDeterministic is like NewDeterministic but panics when the ID couldn't be generated.
+func MakeDeterministic[T Kind](namespace, data []byte, opts ...Option) ID[T] {
+	id, err := NewDeterministic[T](namespace, data, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
+
+// ErrIdempotencyMismatch is returned when an idempotency key was not derived from the request.
+var ErrIdempotencyMismatch = errors.New("sdulid: idempotency key does not match the request")
+
+// IdempotencyKey returns a key for a request that is identified by its fingerprint (e.g. a hash of the
+// method, path and body). The key is a deterministic ID (see NewDeterministic) of the time at, with
+// namespace as the secret key. Clients should pass the time of the first attempt, so every retry of the
+// same request derives the same key. A retried request can present the key again and it can be checked
+// with VerifyIdempotencyKey, without storing the fingerprint in a separate key format. It panics when
+// at can't be encoded in a ULID.
+func IdempotencyKey[T Kind](namespace, fingerprint []byte, at time.Time) ID[T] {
+	return MakeDeterministic[T](namespace, fingerprint, WithTime(at))
+}
+
+// VerifyIdempotencyKey re-derives the key for fingerprint at the timestamp of key and compares them.
+// It returns ErrIdempotencyMismatch when key was derived from another request, or another namespace.
+func VerifyIdempotencyKey[T Kind](namespace []byte, key ID[T], fingerprint []byte) error {
+	exp, err := NewDeterministic[T](namespace, fingerprint, WithTimestampMS(key.Timestamp()))
+	if err != nil {
+		return err
+	}
+
+	if !hmac.Equal(exp.ULID[:], key.ULID[:]) {
+		return ErrIdempotencyMismatch
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package sdulid_test
+
+import (
+	"time"
+
+	"github.com/advdv/sdulid"
+	"github.com/oklog/ulid/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("deterministic", func() {
+	ns, t := []byte("orders"), time.UnixMilli(1730628322885)
+
+	It("should derive the same id from the same data", func() {
+		id1 := sdulid.MakeDeterministic[testID](ns, []byte("order-1"), sdulid.WithTime(t))
+		Expect(id1.Time()).To(Equal(t))
+		Expect(id1.Bytes()[14:]).To(Equal([]byte{255, 255}))
+		Expect(sdulid.MakeDeterministic[testID](ns, []byte("order-1"), sdulid.WithTime(t))).To(Equal(id1))
+
+		Expect(sdulid.MakeDeterministic[testID](ns, []byte("order-2"), sdulid.WithTime(t))).ToNot(Equal(id1))
+		Expect(sdulid.MakeDeterministic[testID]([]byte("other"), []byte("order-1"), sdulid.WithTime(t))).ToNot(Equal(id1))
+	})
+
+	It("should only differ in time without a provided time", func() {
+		id1 := sdulid.MakeDeterministic[testID](ns, []byte("order-1"))
+		id2 := sdulid.MakeDeterministic[testID](ns, []byte("order-1"), sdulid.WithTime(t))
+		Expect(id1.Bytes()[6:]).To(Equal(id2.Bytes()[6:]))
+	})
+
+	It("should fail on invalid times", func() {
+		_, err := sdulid.NewDeterministic[testID](ns, nil, sdulid.WithTimestampMS(ulid.MaxTime()+1))
+		Expect(err).To(MatchError(ulid.ErrBigTime))
+		Expect(func() {
+			sdulid.MakeDeterministic[testID](ns, nil, sdulid.WithTimestampMS(ulid.MaxTime()+1))
+		}).To(Panic())
+	})
+})
+
+var _ = Describe("idempotency key", func() {
+	ns, first := []byte("payments"), time.UnixMilli(1730628322885)
+
+	It("should verify keys of the same request", func() {
+		key := sdulid.IdempotencyKey[testID](ns, []byte("POST /payments {amount: 10}"), first)
+		Expect(key.Time()).To(Equal(first))
+		Expect(sdulid.VerifyIdempotencyKey(ns, key, []byte("POST /payments {amount: 10}"))).To(Succeed())
+
+		parsed := sdulid.MustParse[testID](key.String())
+		Expect(sdulid.VerifyIdempotencyKey(ns, parsed, []byte("POST /payments {amount: 10}"))).To(Succeed())
+	})
+
+	It("should derive the same key on every retry of a request", func() {
+		body, processed := []byte("POST /payments {amount: 10}"), map[sdulid.ID[testID]]int{}
+		attempt := func() {
+			key := sdulid.IdempotencyKey[testID](ns, body, first)
+			Expect(sdulid.VerifyIdempotencyKey(ns, key, body)).To(Succeed())
+			processed[key]++
+		}
+
+		for range 3 {
+			attempt()
+		}
+
+		Expect(processed).To(HaveLen(1))
+		Expect(sdulid.IdempotencyKey[testID](ns, body, first.Add(time.Millisecond))).ToNot(
+			Equal(sdulid.IdempotencyKey[testID](ns, body, first)))
+		Expect(func() {
+			sdulid.IdempotencyKey[testID](ns, body, time.UnixMilli(int64(ulid.MaxTime())+1))
+		}).To(Panic())
+	})
+
+	It("should reject keys of other requests", func() {
+		key := sdulid.IdempotencyKey[testID](ns, []byte("POST /payments {amount: 10}"), first)
+		Expect(sdulid.VerifyIdempotencyKey(ns, key, []byte("POST /payments {amount: 99}"))).To(
+			MatchError(sdulid.ErrIdempotencyMismatch))
+		Expect(sdulid.VerifyIdempotencyKey([]byte("other"), key, []byte("POST /payments {amount: 10}"))).To(
+			MatchError(sdulid.ErrIdempotencyMismatch))
+		Expect(sdulid.VerifyIdempotencyKey(ns, sdulid.Make[testID](), []byte("POST /payments {amount: 10}"))).To(
+			MatchError(sdulid.ErrIdempotencyMismatch))
+	})
+})
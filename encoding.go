@@ -0,0 +1,88 @@
+package sdulid
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidEncoding is returned when the hex or base64url form of an ID can't be decoded.
+var ErrInvalidEncoding = errors.New("sdulid: invalid encoding")
+
+// EncodeHex returns the prefixed hexadecimal form of id, e.g: "tst_0192f17c8645501057fbc2a1ffdeffff".
+// Unlike Crockford base32, it survives systems that normalize case such as some SIEM tools.
+func EncodeHex[T Kind](id ID[T]) string {
+	var kind T
+
+	return kind.KindShortIdent() + "_" + hex.EncodeToString(id.ULID[:])
+}
+
+// DecodeHex decodes the prefixed hexadecimal form that was produced by EncodeHex, in upper or lower
+// case. The prefix and the trailing two bytes must describe T.
+func DecodeHex[T Kind](s string) (id ID[T], err error) {
+	v, err := cutPrefix[T](s)
+	if err != nil {
+		return id, err
+	}
+
+	var b [len(id.ULID)]byte
+	if len(v) != hex.EncodedLen(len(b)) {
+		return id, ErrInvalidEncoding
+	}
+
+	if _, err := hex.Decode(b[:], []byte(v)); err != nil {
+		return id, errors.Join(ErrInvalidEncoding, err)
+	}
+
+	err = id.scanBytes(b[:])
+
+	return id, err
+}
+
+// EncodeBase64URL returns the prefixed, unpadded, base64url form of id, e.g:
+// "tst_AZLxfIZFUBBX-8Kh_97__w". It is shorter than the hex form but case-sensitive.
+func EncodeBase64URL[T Kind](id ID[T]) string {
+	var kind T
+
+	return kind.KindShortIdent() + "_" + base64.RawURLEncoding.EncodeToString(id.ULID[:])
+}
+
+// DecodeBase64URL decodes the prefixed base64url form that was produced by EncodeBase64URL. The prefix
+// and the trailing two bytes must describe T.
+func DecodeBase64URL[T Kind](s string) (id ID[T], err error) {
+	v, err := cutPrefix[T](s)
+	if err != nil {
+		return id, err
+	}
+
+	var b [len(id.ULID)]byte
+	if len(v) != base64.RawURLEncoding.EncodedLen(len(b)) {
+		return id, ErrInvalidEncoding
+	}
+
+	if _, err := base64.RawURLEncoding.Decode(b[:], []byte(v)); err != nil {
+		return id, errors.Join(ErrInvalidEncoding, err)
+	}
+
+	err = id.scanBytes(b[:])
+
+	return id, err
+}
+
+// cutPrefix returns s without the prefix of kind T. The base64url alphabet includes the underscore, so
+// the prefix is matched on the short ident instead of the first separator.
+func cutPrefix[T Kind](s string) (string, error) {
+	var kind T
+
+	if v, ok := strings.CutPrefix(s, kind.KindShortIdent()+"_"); ok {
+		return v, nil
+	}
+
+	before, _, found := strings.Cut(s, "_")
+	if !found {
+		return "", ErrNoPrefix
+	}
+
+	return "", &WrongKindError{Expected: kind.KindShortIdent(), Actual: before}
+}
@@ -0,0 +1,42 @@
+package sdulid_test
+
+import (
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("alternative encodings", func() {
+	id := sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+
+	It("should round trip the hex form", func() {
+		Expect(sdulid.EncodeHex(id)).To(Equal("tst_0192f17c8645501057fbc2a1ffdeffff"))
+		Expect(sdulid.DecodeHex[testID]("tst_0192f17c8645501057fbc2a1ffdeffff")).To(Equal(id))
+		Expect(sdulid.DecodeHex[testID]("tst_0192F17C8645501057FBC2A1FFDEFFFF")).To(Equal(id))
+	})
+
+	It("should round trip the base64url form", func() {
+		Expect(sdulid.EncodeBase64URL(id)).To(Equal("tst_AZLxfIZFUBBX-8Kh_97__w"))
+		Expect(sdulid.DecodeBase64URL[testID]("tst_AZLxfIZFUBBX-8Kh_97__w")).To(Equal(id))
+	})
+
+	DescribeTable("decode errors",
+		func(decode func(string) (sdulid.ID[testID], error), s string, expErr any) {
+			_, err := decode(s)
+			Expect(err).To(MatchError(expErr))
+		},
+		Entry("hex no prefix", sdulid.DecodeHex[testID], "0192f17c8645501057fbc2a1ffdeffff", sdulid.ErrNoPrefix),
+		Entry("hex wrong prefix", sdulid.DecodeHex[testID], "oth_0192f17c8645501057fbc2a1ffde0001",
+			&sdulid.WrongKindError{Expected: "tst", Actual: "oth"}),
+		Entry("hex wrong suffix", sdulid.DecodeHex[testID], "tst_0192f17c8645501057fbc2a1ffde0001",
+			sdulid.ErrInvalidSuffix),
+		Entry("hex too short", sdulid.DecodeHex[testID], "tst_0192f17c", sdulid.ErrInvalidEncoding),
+		Entry("hex invalid", sdulid.DecodeHex[testID], "tst_0192f17c8645501057fbc2a1ffdeffzz", sdulid.ErrInvalidEncoding),
+		Entry("base64 wrong suffix", sdulid.DecodeBase64URL[testID], "tst_AZLxfIZFUBBX-8Kh_94AAQ",
+			sdulid.ErrInvalidSuffix),
+		Entry("base64 too long", sdulid.DecodeBase64URL[testID], "tst_AZLxfIZFUBBX-8Kh_97__w==",
+			sdulid.ErrInvalidEncoding),
+		Entry("base64 invalid", sdulid.DecodeBase64URL[testID], "tst_AZLxfIZFUBBX+8Kh_97__w",
+			sdulid.ErrInvalidEncoding),
+	)
+})
@@ -0,0 +1,81 @@
+package sdulid
+
+import (
+	"bufio"
+	"crypto/rand"
+	"fmt"
+	"io"
+	mrand "math/rand/v2"
+	"slices"
+	"sync"
+)
+
+// defaultEntropyPoolBufferSize is the buffer size of each reader in the pool when none is configured.
+const defaultEntropyPoolBufferSize = 4096
+
+// EntropyPool is an io.Reader that reads from crypto/rand through a pool of buffered readers. The pool
+// is backed by a sync.Pool so goroutines mostly read from a buffer local to their P, without locking
+// and with far fewer calls into crypto/rand. Use it with WithEntropy when many goroutines generate IDs
+// concurrently. It is safe for concurrent use.
+type EntropyPool struct {
+	pool sync.Pool
+}
+
+// NewEntropyPool inits an entropy pool of which each reader buffers size bytes of entropy. When size is
+// zero or negative it defaults to 4096 bytes.
+func NewEntropyPool(size int) *EntropyPool {
+	if size <= 0 {
+		size = defaultEntropyPoolBufferSize
+	}
+
+	return &EntropyPool{pool: sync.Pool{New: func() any {
+		return bufio.NewReaderSize(rand.Reader, size)
+	}}}
+}
+
+// Read fills p with entropy from one of the buffered readers in the pool.
+func (p *EntropyPool) Read(b []byte) (int, error) {
+	r, _ := p.pool.Get().(*bufio.Reader)
+	n, err := io.ReadFull(r, b)
+	p.pool.Put(r)
+
+	return n, err //nolint:wrapcheck
+}
+
+// fastEntropy is a pool of ChaCha8 generators that are each seeded from crypto/rand.
+var fastEntropy = sync.Pool{New: func() any {
+	var seed [32]byte
+	if _, err := rand.Read(seed[:]); err != nil {
+		// a generator with a predictable seed must never be handed out, fail loudly instead.
+		panic(fmt.Sprintf("sdulid: failed to seed fast entropy: %v", err))
+	}
+
+	return mrand.NewChaCha8(seed)
+}}
+
+// fastReader reads entropy from the pool of ChaCha8 generators.
+type fastReader struct{}
+
+func (fastReader) Read(b []byte) (int, error) {
+	r, _ := fastEntropy.Get().(*mrand.ChaCha8)
+	n, err := r.Read(b)
+	fastEntropy.Put(r)
+
+	return n, err //nolint:wrapcheck
+}
+
+// MakeSecure generates an ID with entropy read from crypto/rand, so the entropy is unpredictable. Use
+// it when IDs are exposed and must not be guessable. The entropy policy is the point of MakeSecure, so a
+// WithEntropy in opts is overridden by crypto/rand.
+func MakeSecure[T Kind](opts ...Option) ID[T] {
+	return Make[T](append(slices.Clip(opts), WithEntropy(rand.Reader))...)
+}
+
+// MakeFast generates an ID with entropy from a ChaCha8 generator of math/rand/v2 that is seeded from
+// crypto/rand. It is faster than MakeSecure and never blocks, but a generator's output is only as
+// unpredictable as its seed and the generator state lives in process memory. Use it for internal
+// pipelines where latency matters and IDs are not used as secrets. Like MakeSecure, a WithEntropy in
+// opts is overridden.
+func MakeFast[T Kind](opts ...Option) ID[T] {
+	return Make[T](append(slices.Clip(opts), WithEntropy(fastReader{}))...)
+}
@@ -0,0 +1,71 @@
+package sdulid_test
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("entropy pool", func() {
+	It("should generate unique ids concurrently", func() {
+		pool := sdulid.NewEntropyPool(0)
+
+		var (
+			wg   sync.WaitGroup
+			mu   sync.Mutex
+			seen = map[sdulid.ID[testID]]bool{}
+		)
+
+		for range 8 {
+			wg.Add(1)
+
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				for range 1000 {
+					id, err := sdulid.New[testID](sdulid.WithEntropy(pool))
+					Expect(err).ToNot(HaveOccurred())
+
+					mu.Lock()
+					seen[id] = true
+					mu.Unlock()
+				}
+			}()
+		}
+
+		wg.Wait()
+		Expect(seen).To(HaveLen(8000))
+	})
+
+	It("should fill reads larger than the buffer", func() {
+		buf := make([]byte, 100)
+		n, err := sdulid.NewEntropyPool(16).Read(buf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(100))
+		Expect(buf).ToNot(Equal(make([]byte, 100)))
+	})
+})
+
+var _ = Describe("entropy policies", func() {
+	DescribeTable("make",
+		func(mk func(...sdulid.Option) sdulid.ID[testID]) {
+			id1, id2 := mk(), mk()
+			Expect(id1).ToNot(Equal(id2))
+			Expect(id1.Bytes()[14:]).To(Equal([]byte{255, 255}))
+
+			id3 := mk(sdulid.WithTimestampMS(1730628322885))
+			Expect(id3.Timestamp()).To(Equal(uint64(1730628322885)))
+
+			zeros := bytes.NewReader(make([]byte, 10))
+			id4, id5 := mk(sdulid.WithEntropy(zeros)), mk(sdulid.WithEntropy(zeros))
+			Expect(id4.Bytes()[6:14]).ToNot(Equal(make([]byte, 8)))
+			Expect(id4).ToNot(Equal(id5))
+		},
+		Entry("secure", sdulid.MakeSecure[testID]),
+		Entry("fast", sdulid.MakeFast[testID]),
+	)
+})
@@ -0,0 +1,41 @@
+package sdulid
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Format implements the fmt.Formatter interface. It supports the following verbs:
+//
+//	%s  the prefixed text form, e.g: tst_01JBRQS1J5A085FYY2M7ZXXZ
+//	%q  the prefixed text form, double-quoted
+//	%v  the long 26 character ULID, e.g: 01JBRQS1J5A085FYY2M7ZXXZZZ
+//	%+v a debug view with the kind ident, kind number and timestamp
+//	%x  the 16 raw bytes as lower case hex (%X for upper case)
+func (id ID[T]) Format(f fmt.State, verb rune) {
+	var kind T
+
+	switch verb {
+	case 's':
+		fmt.Fprint(f, id.String())
+	case 'q':
+		fmt.Fprint(f, strconv.Quote(id.String()))
+	case 'v':
+		if f.Flag('+') {
+			fmt.Fprintf(f, "%s(kind=%s number=%d time=%s)",
+				id.String(), kind.KindIdent(), kind.KindNumber(), id.Time().UTC().Format(time.RFC3339Nano))
+
+			return
+		}
+
+		fmt.Fprint(f, id.ULID.String())
+	case 'x':
+		fmt.Fprint(f, hex.EncodeToString(id.ULID[:]))
+	case 'X':
+		fmt.Fprintf(f, "%X", id.ULID[:])
+	default:
+		fmt.Fprintf(f, "%%!%c(sdulid.ID=%s)", verb, id.String())
+	}
+}
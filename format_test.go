@@ -0,0 +1,34 @@
+package sdulid_test
+
+import (
+	"fmt"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("format", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should implement the interface", func() {
+		var _ fmt.Formatter = id1
+	})
+
+	DescribeTable("verbs",
+		func(format, exp string) {
+			Expect(fmt.Sprintf(format, id1)).To(Equal(exp))
+		},
+		Entry("string", "%s", "tst_01JBRQS1J5A085FYY2M7ZXXZ"),
+		Entry("quoted", "%q", `"tst_01JBRQS1J5A085FYY2M7ZXXZ"`),
+		Entry("value", "%v", "01JBRQS1J5A085FYY2M7ZXXZZZ"),
+		Entry("debug", "%+v", "tst_01JBRQS1J5A085FYY2M7ZXXZ(kind=test number=65535 time=2024-11-03T10:05:22.885Z)"),
+		Entry("hex", "%x", "0192f17c8645501057fbc2a1ffdeffff"),
+		Entry("upper hex", "%X", "0192F17C8645501057FBC2A1FFDEFFFF"),
+		Entry("unsupported", "%d", "%!d(sdulid.ID=tst_01JBRQS1J5A085FYY2M7ZXXZ)"),
+	)
+})
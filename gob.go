@@ -0,0 +1,20 @@
+package sdulid
+
+import "errors"
+
+// ErrInvalidGob is returned when gob data is not exactly 16 bytes.
+var ErrInvalidGob = errors.New("sdulid: gob data must be exactly 16 bytes")
+
+// GobEncode implements the gob.GobEncoder interface by encoding the 16 raw bytes.
+func (id ID[T]) GobEncode() ([]byte, error) {
+	return id.ULID.Bytes(), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface. It requires the trailing two bytes to describe T.
+func (id *ID[T]) GobDecode(data []byte) error {
+	if len(data) != len(id.ULID) {
+		return ErrInvalidGob
+	}
+
+	return id.scanBytes(data)
+}
@@ -0,0 +1,35 @@
+package sdulid_test
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("gob", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should round trip in a struct", func() {
+		type payload struct{ ID sdulid.ID[testID] }
+
+		var buf bytes.Buffer
+		Expect(gob.NewEncoder(&buf).Encode(payload{ID: id1})).To(Succeed())
+
+		var p payload
+		Expect(gob.NewDecoder(&buf).Decode(&p)).To(Succeed())
+		Expect(p.ID).To(Equal(id1))
+	})
+
+	It("should verify the suffix and size", func() {
+		var id2 sdulid.ID[otherID]
+		Expect(id2.GobDecode(id1.Bytes())).To(MatchError(sdulid.ErrInvalidSuffix))
+		Expect(id2.GobDecode(id1.Bytes()[:15])).To(MatchError(sdulid.ErrInvalidGob))
+	})
+})
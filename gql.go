@@ -0,0 +1,29 @@
+package sdulid
+
+import (
+	"errors"
+	"io"
+	"strconv"
+)
+
+// ErrInvalidGQL is returned when a GraphQL input value for an ID is not a string.
+var ErrInvalidGQL = errors.New("sdulid: GraphQL value must be a string")
+
+// MarshalGQL implements the gqlgen graphql.Marshaler interface by writing the prefixed text form as
+// a GraphQL string. This allows IDs to be bound to custom scalars, e.g: scalar AccountID.
+func (id ID[T]) MarshalGQL(w io.Writer) {
+	_, _ = io.WriteString(w, strconv.Quote(id.String()))
+}
+
+// UnmarshalGQL implements the gqlgen graphql.Unmarshaler interface. It accepts the prefixed and the
+// long text form.
+func (id *ID[T]) UnmarshalGQL(v any) error {
+	switch x := v.(type) {
+	case string:
+		return id.UnmarshalText([]byte(x))
+	case []byte:
+		return id.UnmarshalText(x)
+	}
+
+	return ErrInvalidGQL
+}
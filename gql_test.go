@@ -0,0 +1,43 @@
+package sdulid_test
+
+import (
+	"bytes"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("gql", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should marshal as string", func() {
+		var buf bytes.Buffer
+		id1.MarshalGQL(&buf)
+		Expect(buf.String()).To(Equal(`"tst_01JBRQS1J5A085FYY2M7ZXXZ"`))
+	})
+
+	DescribeTable("unmarshal",
+		func(v any, expErr error) {
+			var id2 sdulid.ID[testID]
+			err := id2.UnmarshalGQL(v)
+			if expErr != nil {
+				Expect(err).To(MatchError(expErr))
+
+				return
+			}
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(id2).To(Equal(id1))
+		},
+		Entry("prefixed", "tst_01JBRQS1J5A085FYY2M7ZXXZ", nil),
+		Entry("long", "01JBRQS1J5A085FYY2M7ZXXZZZ", nil),
+		Entry("bytes", []byte("tst_01JBRQS1J5A085FYY2M7ZXXZ"), nil),
+		Entry("wrong kind", "oth_01JBRQS1J5A085FYY2M7ZXW0", sdulid.ErrWrongKind),
+		Entry("not a string", 42, sdulid.ErrInvalidGQL),
+	)
+})
@@ -0,0 +1,35 @@
+package sdulid
+
+import "hash/maphash"
+
+// Hash returns the hash of the 16 raw bytes of the ID with seed. IDs can be used as keys of custom
+// containers without converting them to strings.
+func (id ID[T]) Hash(seed maphash.Seed) uint64 {
+	return maphash.Bytes(seed, id.ULID[:])
+}
+
+// Hash returns the hash of the 16 raw bytes of the ID with seed, see ID.Hash.
+func (id AnyID) Hash(seed maphash.Seed) uint64 {
+	return maphash.Bytes(seed, id.u[:])
+}
+
+// Hasher hashes and compares IDs of kind T, as required by generic (e.g. swiss-table) hash maps. Its
+// methods are safe for concurrent use.
+type Hasher[T Kind] struct {
+	seed maphash.Seed
+}
+
+// NewHasher inits a hasher with a random seed.
+func NewHasher[T Kind]() Hasher[T] {
+	return Hasher[T]{seed: maphash.MakeSeed()}
+}
+
+// Hash returns the hash of id with the seed of the hasher.
+func (h Hasher[T]) Hash(id ID[T]) uint64 {
+	return id.Hash(h.seed)
+}
+
+// Equal reports whether a and b are the same ID.
+func (h Hasher[T]) Equal(a, b ID[T]) bool {
+	return a == b
+}
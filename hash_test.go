@@ -0,0 +1,33 @@
+package sdulid_test
+
+import (
+	"hash/maphash"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("hash", func() {
+	It("should hash the bytes", func() {
+		seed := maphash.MakeSeed()
+		id1, id2 := sdulid.Make[testID](), sdulid.Make[testID]()
+
+		Expect(id1.Hash(seed)).To(Equal(maphash.Bytes(seed, id1.Bytes())))
+		Expect(id1.Hash(seed)).ToNot(Equal(id2.Hash(seed)))
+
+		aid, _, err := sdulid.MustNewRegistry(testID{}).ParseAny(id1.String())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(aid.Hash(seed)).To(Equal(id1.Hash(seed)))
+	})
+
+	It("should hash and compare with a hasher", func() {
+		hasher := sdulid.NewHasher[testID]()
+		id1, id2 := sdulid.Make[testID](), sdulid.Make[testID]()
+
+		Expect(hasher.Hash(id1)).To(Equal(hasher.Hash(id1)))
+		Expect(hasher.Hash(id1)).ToNot(Equal(hasher.Hash(id2)))
+		Expect(hasher.Equal(id1, id1)).To(BeTrue())
+		Expect(hasher.Equal(id1, id2)).To(BeFalse())
+	})
+})
@@ -0,0 +1,44 @@
+package sdulid
+
+import (
+	"expvar"
+	"strconv"
+)
+
+// Hook observes the generation of IDs by makers, e.g. to track the number of IDs generated per kind
+// for capacity monitoring. Hooks are called synchronously so implementations must be fast and safe for
+// concurrent use.
+type Hook interface {
+	// OnMake is called after an ID of the kind with kindNumber was generated.
+	OnMake(kindNumber uint16)
+	// OnOverflow is called when a monotonic maker ran out of entropy within a single millisecond.
+	OnOverflow(kindNumber uint16)
+}
+
+// ExpvarHook is a Hook that counts generated IDs and overflows per kind number in expvar maps.
+type ExpvarHook struct {
+	made      *expvar.Map
+	overflows *expvar.Map
+}
+
+// NewExpvarHook publishes a map with the "made" and "overflows" counters per kind number under name.
+// Like expvar.Publish it panics when name is already published.
+func NewExpvarHook(name string) *ExpvarHook {
+	h := &ExpvarHook{made: new(expvar.Map), overflows: new(expvar.Map)}
+
+	m := expvar.NewMap(name)
+	m.Set("made", h.made)
+	m.Set("overflows", h.overflows)
+
+	return h
+}
+
+// OnMake implements Hook.
+func (h *ExpvarHook) OnMake(kindNumber uint16) {
+	h.made.Add(strconv.FormatUint(uint64(kindNumber), 10), 1)
+}
+
+// OnOverflow implements Hook.
+func (h *ExpvarHook) OnOverflow(kindNumber uint16) {
+	h.overflows.Add(strconv.FormatUint(uint64(kindNumber), 10), 1)
+}
@@ -0,0 +1,29 @@
+package sdulid_test
+
+import (
+	"expvar"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("hooks", func() {
+	It("should count generated ids in expvar", func() {
+		hook := sdulid.NewExpvarHook("sdulid_test_hook")
+
+		sdulid.CryptoMaker[testID]{Hook: hook}.Make()
+		sdulid.CryptoMaker[testID]{Hook: hook}.MakeBatch(3)
+		sdulid.NewMonotonicMaker[otherID](nil, 0, sdulid.WithHook(hook)).Make()
+		sdulid.NewMonotonicMaker[otherID](nil, 0, sdulid.WithHook(hook)).MakeBatch(2)
+		hook.OnOverflow(1)
+
+		sharded := must(sdulid.NewShardedMaker[testID](4, 9))
+		sharded.Hook = hook
+		sharded.Make()
+		sharded.MakeBatch(2)
+
+		Expect(expvar.Get("sdulid_test_hook").String()).To(MatchJSON(
+			`{"made": {"1": 3, "65535": 7}, "overflows": {"1": 1}}`))
+	})
+})
@@ -5,23 +5,65 @@ package sdulid
 
 import (
 	"bytes"
+	"crypto/subtle"
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
+	"slices"
+	"time"
 
 	"github.com/oklog/ulid/v2"
 )
 
 var (
 	// ErrNoPrefix is returned when a self-describing ulid is parsed without a prefix.
-	ErrNoPrefix = errors.New("sdulid: no prefix")
+	ErrNoPrefix error = &codedError{ParseErrorNoPrefix, "sdulid: no prefix"}
 	// ErrInvalidSuffix is returned during text decoding when the long form (no prefix) ulid is
 	// provided and the last two bytes don't match what is expected for the type that it's decoding into.
-	ErrInvalidSuffix = errors.New("sdulid: invalid ulid suffix")
+	ErrInvalidSuffix error = &codedError{ParseErrorInvalidSuffix, "sdulid: invalid ulid suffix"}
 	// ErrBufferSize is returned when marshalling ULIDs to a buffer of insufficient size.
-	ErrBufferSize = errors.New("sdulid: bad buffer size when marshaling")
+	ErrBufferSize error = &codedError{ParseErrorBufferSize, "sdulid: bad buffer size when marshaling"}
+	// ErrScanValue is returned when the value passed to Scan cannot be decoded into an ID.
+	ErrScanValue = errors.New("sdulid: source value must be a string, byte slice or byte array")
+	// ErrWrongKind is matched (using errors.Is) by a WrongKindError.
+	ErrWrongKind error = &codedError{ParseErrorWrongKind, "sdulid: wrong kind"}
+	// ErrMonotonicOverflow is returned by a monotonic maker when incrementing the previous ID's
+	// entropy bytes would result in overflow.
+	ErrMonotonicOverflow = errors.New("sdulid: monotonic entropy overflow")
 )
 
+// WrongKindError is returned when text is decoded that is prefixed with the short ident of another
+// kind. It matches ErrWrongKind when using errors.Is.
+type WrongKindError struct {
+	// Expected is the short ident of the kind that was being decoded.
+	Expected string
+	// Actual is the prefix that was found in the text.
+	Actual string
+}
+
+func (e *WrongKindError) Error() string {
+	return fmt.Sprintf("sdulid: wrong kind: expected prefix %q, got %q", e.Expected, e.Actual)
+}
+
+// Is reports whether target is ErrWrongKind, ErrParse, or a WrongKindError with the same fields.
+func (e *WrongKindError) Is(target error) bool {
+	if other, ok := target.(*WrongKindError); ok {
+		return *other == *e
+	}
+
+	return target == ErrWrongKind || target == ErrParse
+}
+
+// ParseErrorCode returns ParseErrorWrongKind.
+func (e *WrongKindError) ParseErrorCode() ParseErrorCode {
+	return ParseErrorWrongKind
+}
+
+// stringBufSize is the size of the stack buffer that String encodes into, so that the returned string
+// is the only allocation for short idents of the default maximum length (and well beyond).
+const stringBufSize = 64
+
 // ID type used for all entity IDs.
 type ID[T Kind] struct{ ulid.ULID }
 
@@ -30,12 +72,43 @@ func (id *ID[T]) putSuffixBytes() {
 	binary.BigEndian.PutUint16(id.ULID[14:], kind.KindNumber())
 }
 
+// String returns the prefixed text form. Text forms of the same kind sort like the IDs, see SortIDs.
 func (id ID[T]) String() string {
-	d, _ := id.MarshalText()
+	var buf [stringBufSize]byte
+	d, _ := id.AppendText(buf[:0])
 
 	return string(d)
 }
 
+// Compare returns an integer comparing id and other lexicographically by their bytes. The result
+// is 0 if id == other, -1 if id < other and +1 if id > other.
+func (id ID[T]) Compare(other ID[T]) int {
+	return id.ULID.Compare(other.ULID)
+}
+
+// Less reports whether id sorts before other.
+func (id ID[T]) Less(other ID[T]) bool {
+	return id.Compare(other) < 0
+}
+
+// Equal reports whether id and other are the same ID.
+func (id ID[T]) Equal(other ID[T]) bool {
+	return id.ULID == other.ULID
+}
+
+// EqualConstantTime is like Equal but takes the same time regardless of where a and b differ. Use it
+// when IDs act as bearer capabilities, e.g. in signed URLs or unsubscribe tokens, so comparing a
+// guessed ID doesn't leak how much of it was right through timing.
+func EqualConstantTime[T Kind](a, b ID[T]) bool {
+	return subtle.ConstantTimeCompare(a.ULID[:], b.ULID[:]) == 1
+}
+
+// CompareFunc returns a comparison function for IDs of kind T, for use with generic containers and
+// functions such as slices.SortFunc and slices.BinarySearchFunc.
+func CompareFunc[T Kind]() func(a, b ID[T]) int {
+	return ID[T].Compare
+}
+
 // PrefixSize returns the size of the prefix for text encoding.
 func (id ID[T]) PrefixSize() int {
 	var kind T
@@ -48,48 +121,91 @@ func (id ID[T]) EncodedSize() int {
 	return id.PrefixSize() + ulid.EncodedSize - binary.Size(uint16(0))
 }
 
-// MarshalTextTo encodes the id in its text representation with the prefix.
+// MarshalTextTo encodes the id in its text representation with the prefix. The length of dst must be
+// exactly EncodedSize, see PutText for a variant that accepts larger buffers.
 func (id ID[T]) MarshalTextTo(dst []byte) error {
 	if len(dst) != id.EncodedSize() {
 		return ErrBufferSize
 	}
 
-	// write the prefix to the buffer.
-	var kind T
-	copy(dst, []byte(kind.KindShortIdent()+"_"))
+	encodeTextOf[T](dst, id.ULID)
+
+	return nil
+}
+
+// PutText encodes the id in its text representation with the prefix into the start of dst and returns
+// the number of bytes written, like binary.PutUvarint. It returns ErrBufferSize when dst is shorter than
+// EncodedSize.
+func (id ID[T]) PutText(dst []byte) (int, error) {
+	n := id.EncodedSize()
+	if len(dst) < n {
+		return 0, ErrBufferSize
+	}
 
+	encodeTextOf[T](dst[:n], id.ULID)
+
+	return n, nil
+}
+
+// encodeText writes the prefixed text form of u to dst, which must be exactly of the encoded size.
+func encodeText(dst []byte, shortIdent string, u ulid.ULID) {
+	plen := copy(dst, shortIdent)
+	dst[plen] = '_'
+	encodeULIDText(dst[plen+1:], u)
+}
+
+// encodeTextOf is like encodeText for kind T, but copies the cached prefix of T, see prefixOf.
+func encodeTextOf[T shortIdenter](dst []byte, u ulid.ULID) {
+	encodeULIDText(dst[copy(dst, prefixOf[T]()):], u)
+}
+
+// encodeULIDText writes the 24 characters of the text form of u that follow the prefix to dst.
+func encodeULIDText(dst []byte, u ulid.ULID) {
 	// Optimized unrolled loop ahead.
 	// From https://github.com/RobThree/NUlid
-	plen := id.PrefixSize()
 	// 10 byte timestamp
-	dst[plen+0] = ulid.Encoding[(id.ULID[0]&224)>>5]
-	dst[plen+1] = ulid.Encoding[id.ULID[0]&31]
-	dst[plen+2] = ulid.Encoding[(id.ULID[1]&248)>>3]
-	dst[plen+3] = ulid.Encoding[((id.ULID[1]&7)<<2)|((id.ULID[2]&192)>>6)]
-	dst[plen+4] = ulid.Encoding[(id.ULID[2]&62)>>1]
-	dst[plen+5] = ulid.Encoding[((id.ULID[2]&1)<<4)|((id.ULID[3]&240)>>4)]
-	dst[plen+6] = ulid.Encoding[((id.ULID[3]&15)<<1)|((id.ULID[4]&128)>>7)]
-	dst[plen+7] = ulid.Encoding[(id.ULID[4]&124)>>2]
-	dst[plen+8] = ulid.Encoding[((id.ULID[4]&3)<<3)|((id.ULID[5]&224)>>5)]
-	dst[plen+9] = ulid.Encoding[id.ULID[5]&31]
+	dst[0] = ulid.Encoding[(u[0]&224)>>5]
+	dst[1] = ulid.Encoding[u[0]&31]
+	dst[2] = ulid.Encoding[(u[1]&248)>>3]
+	dst[3] = ulid.Encoding[((u[1]&7)<<2)|((u[2]&192)>>6)]
+	dst[4] = ulid.Encoding[(u[2]&62)>>1]
+	dst[5] = ulid.Encoding[((u[2]&1)<<4)|((u[3]&240)>>4)]
+	dst[6] = ulid.Encoding[((u[3]&15)<<1)|((u[4]&128)>>7)]
+	dst[7] = ulid.Encoding[(u[4]&124)>>2]
+	dst[8] = ulid.Encoding[((u[4]&3)<<3)|((u[5]&224)>>5)]
+	dst[9] = ulid.Encoding[u[5]&31]
 
 	// 16 bytes of entropy
-	dst[plen+10] = ulid.Encoding[(id.ULID[6]&248)>>3]
-	dst[plen+11] = ulid.Encoding[((id.ULID[6]&7)<<2)|((id.ULID[7]&192)>>6)]
-	dst[plen+12] = ulid.Encoding[(id.ULID[7]&62)>>1]
-	dst[plen+13] = ulid.Encoding[((id.ULID[7]&1)<<4)|((id.ULID[8]&240)>>4)]
-	dst[plen+14] = ulid.Encoding[((id.ULID[8]&15)<<1)|((id.ULID[9]&128)>>7)]
-	dst[plen+15] = ulid.Encoding[(id.ULID[9]&124)>>2]
-	dst[plen+16] = ulid.Encoding[((id.ULID[9]&3)<<3)|((id.ULID[10]&224)>>5)]
-	dst[plen+17] = ulid.Encoding[id.ULID[10]&31]
-	dst[plen+18] = ulid.Encoding[(id.ULID[11]&248)>>3]
-	dst[plen+19] = ulid.Encoding[((id.ULID[11]&7)<<2)|((id.ULID[12]&192)>>6)]
-	dst[plen+20] = ulid.Encoding[(id.ULID[12]&62)>>1]
-	dst[plen+21] = ulid.Encoding[((id.ULID[12]&1)<<4)|((id.ULID[13]&240)>>4)]
-	dst[plen+22] = ulid.Encoding[((id.ULID[13]&15)<<1)|((id.ULID[14]&128)>>7)]
-	dst[plen+23] = ulid.Encoding[(id.ULID[14]&124)>>2]
+	dst[10] = ulid.Encoding[(u[6]&248)>>3]
+	dst[11] = ulid.Encoding[((u[6]&7)<<2)|((u[7]&192)>>6)]
+	dst[12] = ulid.Encoding[(u[7]&62)>>1]
+	dst[13] = ulid.Encoding[((u[7]&1)<<4)|((u[8]&240)>>4)]
+	dst[14] = ulid.Encoding[((u[8]&15)<<1)|((u[9]&128)>>7)]
+	dst[15] = ulid.Encoding[(u[9]&124)>>2]
+	dst[16] = ulid.Encoding[((u[9]&3)<<3)|((u[10]&224)>>5)]
+	dst[17] = ulid.Encoding[u[10]&31]
+	dst[18] = ulid.Encoding[(u[11]&248)>>3]
+	dst[19] = ulid.Encoding[((u[11]&7)<<2)|((u[12]&192)>>6)]
+	dst[20] = ulid.Encoding[(u[12]&62)>>1]
+	dst[21] = ulid.Encoding[((u[12]&1)<<4)|((u[13]&240)>>4)]
+	dst[22] = ulid.Encoding[((u[13]&15)<<1)|((u[14]&128)>>7)]
+	dst[23] = ulid.Encoding[(u[14]&124)>>2]
+}
 
-	return nil
+// AppendText implements the encoding.TextAppender interface by appending the prefixed text form to
+// dst. It allows hot paths to re-use buffers and never returns an error.
+func (id ID[T]) AppendText(dst []byte) ([]byte, error) {
+	n := len(dst)
+	dst = slices.Grow(dst, id.EncodedSize())[:n+id.EncodedSize()]
+	encodeTextOf[T](dst[n:], id.ULID)
+
+	return dst, nil
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface by appending the 16 raw bytes to
+// dst. It never returns an error.
+func (id ID[T]) AppendBinary(dst []byte) ([]byte, error) {
+	return append(dst, id.ULID[:]...), nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface by
@@ -103,27 +219,41 @@ func (id ID[T]) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface by
 // parsing the data as string encoded ULID while requiring the short ident as prefix.
+// It decodes the prefixed form without allocating. Errors are returned as a *ParseError.
 func (id *ID[T]) UnmarshalText(v []byte) error {
 	var kind T
-	var suffix [2]byte
-	binary.BigEndian.PutUint16(suffix[:], kind.KindNumber())
 
-	before, after, found := bytes.Cut(v, []byte(kind.KindShortIdent()+"_"))
-	if !found && len(before) == ulid.EncodedSize {
-		if err := id.ULID.UnmarshalText(before); err != nil {
-			return err //nolint:wrapcheck
-		}
+	if start, err := id.unmarshalText(kind, v); err != nil {
+		return newParseError(v, start, kind.KindShortIdent(), err)
+	}
+
+	return nil
+}
 
-		if id.ULID[14] != suffix[0] || id.ULID[15] != suffix[1] {
-			return ErrInvalidSuffix
+// unmarshalText decodes v, it returns the offset at which the text form starts after the prefix.
+func (id *ID[T]) unmarshalText(kind T, v []byte) (int, error) {
+	shortIdent := kind.KindShortIdent()
+	if len(v) > len(shortIdent) && string(v[:len(shortIdent)]) == shortIdent && v[len(shortIdent)] == '_' {
+		return len(shortIdent) + 1, decodeText(&id.ULID, v[len(shortIdent)+1:], kind.KindNumber())
+	} else if i := bytes.IndexByte(v, '_'); i >= 0 {
+		if isAlias(kind, v[:i]) {
+			return i + 1, decodeText(&id.ULID, v[i+1:], kind.KindNumber())
 		}
 
-		return nil
-	} else if !found {
-		return ErrNoPrefix
+		return 0, &WrongKindError{Expected: shortIdent, Actual: string(v[:i])}
+	} else if len(v) != ulid.EncodedSize {
+		return 0, ErrNoPrefix
 	}
 
-	return id.ULID.UnmarshalText(append(after, suffix[:]...)) //nolint:wrapcheck
+	if err := decodeLong(&id.ULID, v); err != nil {
+		return 0, err
+	}
+
+	if binary.BigEndian.Uint16(id.ULID[14:]) != kind.KindNumber() {
+		return 0, ErrInvalidSuffix
+	}
+
+	return 0, nil
 }
 
 // Kind describes the entity kind.
@@ -133,14 +263,121 @@ type Kind interface {
 	KindShortIdent() string
 }
 
-// Make generates a new self-describing ULID.
-func Make[T Kind]() (id ID[T]) {
+// Option configures how a new ID is generated.
+type Option func(*options)
+
+type options struct {
+	ms      *uint64
+	entropy io.Reader
+	clock   Clock
+}
+
+// WithTime generates the ID with the timestamp of t instead of the current time.
+func WithTime(t time.Time) Option {
+	return WithTimestampMS(ulid.Timestamp(t))
+}
+
+// WithTimestampMS generates the ID with the provided Unix milliseconds instead of the current time.
+func WithTimestampMS(ms uint64) Option {
+	return func(o *options) { o.ms = &ms }
+}
+
+// WithEntropy generates the ID with entropy read from r instead of the default entropy source.
+func WithEntropy(r io.Reader) Option {
+	return func(o *options) { o.entropy = r }
+}
+
+// New generates a new self-describing ULID while allowing for options to determine the timestamp
+// and entropy source. An error is returned when the timestamp is too large or reading entropy fails.
+func New[T Kind](opts ...Option) (id ID[T], err error) {
+	if len(opts) == 0 {
+		return Make[T](), nil
+	}
+
+	id.ULID, err = newULID(opts)
+	if err != nil {
+		return id, err
+	}
+
+	id.putSuffixBytes()
+
+	return id, nil
+}
+
+// newULID generates a ULID with the timestamp and entropy source as configured by opts.
+func newULID(opts []Option) (ulid.ULID, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	ms := now(o.clock)
+	if o.ms != nil {
+		ms = *o.ms
+	}
+
+	if o.entropy == nil {
+		o.entropy = ulid.DefaultEntropy()
+	}
+
+	u, err := ulid.New(ms, o.entropy)
+	if err != nil {
+		return u, fmt.Errorf("failed to generate ulid: %w", err)
+	}
+
+	return u, nil
+}
+
+// Make generates a new self-describing ULID. It panics if any of the options cause the generation
+// to fail, use New to handle such errors.
+func Make[T Kind](opts ...Option) (id ID[T]) {
+	if len(opts) > 0 {
+		id, err := New[T](opts...)
+		if err != nil {
+			panic(err)
+		}
+
+		return id
+	}
+
 	id.ULID = ulid.Make()
 	id.putSuffixBytes()
 
 	return
 }
 
+// Zero returns the smallest possible ID of kind T: the timestamp and entropy are all zero but the
+// kind suffix is set. It is useful as a sentinel and as a lower bound in range queries.
+func Zero[T Kind]() (id ID[T]) {
+	id.putSuffixBytes()
+
+	return
+}
+
+// Max returns the largest possible ID of kind T: the timestamp and entropy have all bits set but
+// the kind suffix is set. It is useful as a sentinel and as an upper bound in range queries.
+func Max[T Kind]() (id ID[T]) {
+	for i := range id.ULID {
+		id.ULID[i] = 0xFF
+	}
+
+	id.putSuffixBytes()
+
+	return
+}
+
+// IsZero reports whether the timestamp and entropy of the id are all zero, ignoring the kind suffix.
+// It is true for both the zero value and the ID returned by Zero.
+func (id ID[T]) IsZero() bool {
+	return [14]byte(id.ULID[:14]) == [14]byte{}
+}
+
+// MakeAt generates a new self-describing ULID with the timestamp of t, for example to backfill
+// historical records.
+func MakeAt[T Kind](t time.Time) ID[T] {
+	return Make[T](WithTime(t))
+}
+
 // MustFromULID parses s as a ULID but sets the trailing two bytes to make it describe T.
 func MustFromULID[T Kind](s string) (id ID[T]) {
 	id, err := FromULID[T](s)
@@ -151,9 +388,10 @@ func MustFromULID[T Kind](s string) (id ID[T]) {
 	return id
 }
 
-// FromULID parses s as a ULID while erroring if the ulid parsing fails.
+// FromULID parses s as a ULID while erroring if the ulid parsing fails. Values above the 128 bit maximum
+// are always rejected, invalid characters only in ParseModeStrict, see parseULID.
 func FromULID[T Kind](s string) (id ID[T], err error) {
-	id.ULID, err = ulid.Parse(s)
+	id.ULID, err = parseULID(s)
 	if err != nil {
 		return id, fmt.Errorf("failed to parse ulid: %w", err)
 	}
@@ -163,61 +401,20 @@ func FromULID[T Kind](s string) (id ID[T], err error) {
 	return
 }
 
-// CreateDomainSQL generates SQL for a PostgreSQL domain that constrains the ID
-// by checking the length and the 2-byte suffix for the entity type.
-func CreateDomainSQL[T Kind]() string {
-	var kind T
+// Parse parses s in the prefixed text form (or the long form) into an ID that describes T. It
+// behaves exactly like UnmarshalText.
+func Parse[T Kind](s string) (id ID[T], err error) {
+	err = id.UnmarshalText([]byte(s))
 
-	return fmt.Sprintf(`
-		CREATE DOMAIN %s_id AS bytea 
-		CHECK (
-			octet_length(VALUE) = 16 AND 
-			get_byte(VALUE, 14) = %d AND 
-			get_byte(VALUE, 15) = %d
-		)`,
-		kind.KindIdent(),
-		kind.KindNumber()>>8,   //nolint:mnd
-		kind.KindNumber()&0xFF, //nolint:mnd
-	)
-}
-
-// CreateGeneratorSQL returns the SQL for creating a PostgreSQL function for generating ULIDs in binary (BYTEA) format.
-// The last two bytes of the ULID will be set to the KindNumber in big-endian format.
-func CreateGeneratorSQL[T Kind]() string {
-	var kind T
-	kindNumber := kind.KindNumber()
-
-	return fmt.Sprintf(`CREATE FUNCTION generate_%s_id()
-	RETURNS BYTEA
-	AS $$
-	DECLARE
-		timestamp  BYTEA = E'\\000\\000\\000\\000\\000\\000';
-		kind_bytes BYTEA = E'\\000\\000';
-		unix_time  BIGINT;
-		ulid       BYTEA;
-	BEGIN
-		-- Generate the 6-byte timestamp
-		unix_time = (EXTRACT(EPOCH FROM CLOCK_TIMESTAMP()) * 1000)::BIGINT;
-		timestamp = SET_BYTE(timestamp, 0, (unix_time >> 40)::BIT(8)::INTEGER);
-		timestamp = SET_BYTE(timestamp, 1, (unix_time >> 32)::BIT(8)::INTEGER);
-		timestamp = SET_BYTE(timestamp, 2, (unix_time >> 24)::BIT(8)::INTEGER);
-		timestamp = SET_BYTE(timestamp, 3, (unix_time >> 16)::BIT(8)::INTEGER);
-		timestamp = SET_BYTE(timestamp, 4, (unix_time >> 8)::BIT(8)::INTEGER);
-		timestamp = SET_BYTE(timestamp, 5, unix_time::BIT(8)::INTEGER);
-
-		-- Generate 10 random bytes for entropy
-		ulid = timestamp || gen_random_bytes(8);
-
-		-- Set the last two bytes to the KindNumber in big-endian format
-		kind_bytes = SET_BYTE(kind_bytes, 0, (%d >> 8) & 255);  -- High byte (big-endian)
-		kind_bytes = SET_BYTE(kind_bytes, 1, %d & 255);          -- Low byte (big-endian)
-
-		-- Concatenate the ULID with the big-endian KindNumber bytes
-		ulid = ulid || kind_bytes;
-
-		RETURN ulid;
-	END
-	$$
-	LANGUAGE plpgsql
-	VOLATILE;`, kind.KindIdent(), kindNumber, kindNumber)
+	return id, err
+}
+
+// MustParse is like Parse but panics if s cannot be parsed.
+func MustParse[T Kind](s string) ID[T] {
+	id, err := Parse[T](s)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
 }
@@ -0,0 +1,220 @@
+package sdulid
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// MaxKindNumber24 is the largest kind number of a KindWide.
+const MaxKindNumber24 = 1<<24 - 1
+
+// KindWide describes a kind of which the number needs up to 3 bytes, see ID24. Kind numbers larger
+// than MaxKindNumber24 cause a panic when an ID24 is generated or decoded.
+type KindWide interface {
+	KindNumber() uint32
+	KindIdent() string
+	KindShortIdent() string
+}
+
+// textSize24 is the size of the text form of an ID24 without the prefix: a ulid without the last 4 characters.
+const textSize24 = ulid.EncodedSize - 4
+
+// ID24 is a self-describing ULID that reserves the last 3 bytes for the kind, for applications with
+// more than 65536 kinds (e.g. tenant-scoped kinds). The trade-off is entropy: only 56 bits remain
+// instead of 64. By the birthday bound, n IDs generated within the same millisecond collide with a
+// probability of about n²/2^57. For a million IDs per millisecond that is ~7·10⁻⁶, instead of
+// ~3·10⁻⁸ with 64 bits. A billion IDs within one millisecond would almost certainly collide (~7
+// expected collisions), where 64 bits give a ~2.7% chance. The text form is the short ident, an
+// underscore and 22 characters: e.g. "wde_01JBRQS1J5A085FYY2M7ZT".
+type ID24[T KindWide] struct {
+	ulid.ULID
+}
+
+// New24 is like New but for kinds with a 3-byte kind number.
+func New24[T KindWide](opts ...Option) (id ID24[T], err error) {
+	id.ULID, err = newULID(opts)
+	if err != nil {
+		return id, err
+	}
+
+	id.putSuffixBytes()
+
+	return id, nil
+}
+
+// Make24 is like Make but for kinds with a 3-byte kind number.
+func Make24[T KindWide](opts ...Option) ID24[T] {
+	id, err := New24[T](opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
+
+// FromULID24 is like FromULID but for kinds with a 3-byte kind number.
+func FromULID24[T KindWide](s string) (id ID24[T], err error) {
+	id.ULID, err = parseULID(s)
+	if err != nil {
+		return id, fmt.Errorf("failed to parse ulid: %w", err)
+	}
+
+	id.putSuffixBytes()
+
+	return
+}
+
+// Parse24 parses the prefixed or long text form into an ID24 of kind T.
+func Parse24[T KindWide](s string) (id ID24[T], err error) {
+	err = id.UnmarshalText([]byte(s))
+
+	return id, err
+}
+
+// MustParse24 is like Parse24 but panics on error.
+func MustParse24[T KindWide](s string) ID24[T] {
+	id, err := Parse24[T](s)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
+
+// kindNumber24 returns the kind number of T as 3 big-endian bytes.
+func kindNumber24[T KindWide]() [3]byte {
+	var kind T
+
+	n := kind.KindNumber()
+	if n > MaxKindNumber24 {
+		panic(fmt.Sprintf("sdulid: kind number %d of %q does not fit in 3 bytes", n, kind.KindIdent()))
+	}
+
+	return [3]byte{byte(n >> 16), byte(n >> 8), byte(n)} //nolint:mnd
+}
+
+func (id *ID24[T]) putSuffixBytes() {
+	suffix := kindNumber24[T]()
+	copy(id.ULID[13:], suffix[:])
+}
+
+// EncodedSize returns the size of the prefixed text form.
+func (id ID24[T]) EncodedSize() int {
+	var kind T
+
+	return len(kind.KindShortIdent()) + 1 + textSize24
+}
+
+// String returns the prefixed text form.
+func (id ID24[T]) String() string {
+	var buf [stringBufSize]byte
+	d, _ := id.AppendText(buf[:0])
+
+	return string(d)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface by returning the prefixed text form.
+func (id ID24[T]) MarshalText() ([]byte, error) {
+	return id.AppendText(make([]byte, 0, id.EncodedSize()+2)) //nolint:mnd
+}
+
+// AppendText implements the encoding.TextAppender interface by appending the prefixed text form to dst.
+func (id ID24[T]) AppendText(dst []byte) ([]byte, error) {
+	// the text form is a prefix of the text form of ID, so encode that and drop the last 2 characters.
+	n := len(dst)
+	dst = append(dst, make([]byte, id.EncodedSize()+2)...) //nolint:mnd
+	encodeTextOf[T](dst[n:], id.ULID)
+
+	return dst[:len(dst)-2], nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It accepts the prefixed and the
+// long text form and checks that the text describes kind T.
+func (id *ID24[T]) UnmarshalText(v []byte) error {
+	var kind T
+
+	shortIdent := kind.KindShortIdent()
+	if len(v) > len(shortIdent) && string(v[:len(shortIdent)]) == shortIdent && v[len(shortIdent)] == '_' {
+		return decodeText24(&id.ULID, v[len(shortIdent)+1:], kindNumber24[T]())
+	} else if i := bytes.IndexByte(v, '_'); i >= 0 {
+		return &WrongKindError{Expected: shortIdent, Actual: string(v[:i])}
+	} else if len(v) != ulid.EncodedSize {
+		return ErrNoPrefix
+	}
+
+	if err := decodeLong(&id.ULID, v); err != nil {
+		return err
+	}
+
+	if suffix := kindNumber24[T](); !bytes.Equal(id.ULID[13:], suffix[:]) {
+		return ErrInvalidSuffix
+	}
+
+	return nil
+}
+
+// Value implements the sql/driver.Valuer interface by returning the 16 raw bytes.
+func (id ID24[T]) Value() (driver.Value, error) {
+	return id.ULID.Bytes(), nil
+}
+
+// Scan implements the sql.Scanner interface. Like ID it accepts the raw bytes and both text forms,
+// the last 3 bytes are checked to describe T.
+func (id *ID24[T]) Scan(src any) error {
+	var b []byte
+
+	switch x := src.(type) {
+	case nil:
+		return nil
+	case string:
+		return id.UnmarshalText([]byte(x))
+	case []byte:
+		if len(x) != len(id.ULID) {
+			return id.UnmarshalText(x)
+		}
+
+		b = x
+	case [16]byte:
+		b = x[:]
+	default:
+		return ErrScanValue
+	}
+
+	if suffix := kindNumber24[T](); !bytes.Equal(b[13:], suffix[:]) {
+		return ErrInvalidSuffix
+	}
+
+	copy(id.ULID[:], b)
+
+	return nil
+}
+
+// decodeText24 decodes the 22 characters of the ID24 text form (without prefix) into dst and sets the
+// kind bytes. The last character carries the upper 4 bits of the kind, they must match.
+func decodeText24(dst *ulid.ULID, v []byte, suffix [3]byte) error {
+	if len(v) != textSize24 {
+		return ulid.ErrDataSize
+	}
+
+	// pad to the size of the regular text form so the same (unrolled) decoding can be used.
+	var padded [textSize]byte
+	copy(padded[:], v)
+	padded[22], padded[23] = '0', '0'
+
+	dec := decoder()
+	if err := checkText(dec, padded[:]); err != nil {
+		return err
+	}
+
+	decodeTimeAndEntropy(dst, dec, padded[:])
+	if dst[13]&0xF0 != suffix[0]&0xF0 {
+		return ErrInvalidSuffix
+	}
+
+	copy(dst[13:], suffix[:])
+
+	return nil
+}
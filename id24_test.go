@@ -0,0 +1,105 @@
+package sdulid_test
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/advdv/sdulid"
+	"github.com/oklog/ulid/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type wideID struct{}
+
+func (wideID) KindNumber() uint32     { return 0xABCDEF }
+func (wideID) KindIdent() string      { return "wide" }
+func (wideID) KindShortIdent() string { return "wde" }
+
+type otherWideID struct{}
+
+func (otherWideID) KindNumber() uint32     { return 0x0BCDEF }
+func (otherWideID) KindIdent() string      { return "other_wide" }
+func (otherWideID) KindShortIdent() string { return "owd" }
+
+type tooWideID struct{}
+
+func (tooWideID) KindNumber() uint32     { return sdulid.MaxKindNumber24 + 1 }
+func (tooWideID) KindIdent() string      { return "too_wide" }
+func (tooWideID) KindShortIdent() string { return "twd" }
+
+var _ = Describe("id24", func() {
+	var id1 sdulid.ID24[wideID]
+
+	BeforeEach(func() {
+		var err error
+		id1, err = sdulid.FromULID24[wideID]("01JBRQS1J5A085FYY2M7ZXWG00")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should reserve the last 3 bytes", func() {
+		Expect(id1.Bytes()).To(Equal([]byte{1, 146, 241, 124, 134, 69, 80, 16, 87, 251, 194, 161, 255, 0xAB, 0xCD, 0xEF}))
+	})
+
+	It("should round trip the text form", func() {
+		Expect(id1.String()).To(Equal("wde_01JBRQS1J5A085FYY2M7ZT"))
+		Expect(id1.EncodedSize()).To(Equal(len("wde_01JBRQS1J5A085FYY2M7ZT")))
+		Expect(sdulid.MustParse24[wideID]("wde_01JBRQS1J5A085FYY2M7ZT")).To(Equal(id1))
+		Expect(sdulid.MustParse24[wideID](id1.ULID.String())).To(Equal(id1))
+
+		for range 100 {
+			id2 := sdulid.Make24[wideID]()
+			Expect(sdulid.MustParse24[wideID](id2.String())).To(Equal(id2))
+		}
+	})
+
+	DescribeTable("invalid text",
+		func(s string, expErr error) {
+			_, err := sdulid.Parse24[wideID](s)
+			Expect(err).To(MatchError(expErr))
+		},
+		Entry("too short", "wde_01JBRQS1J5A085FYY2M7Z", ulid.ErrDataSize),
+		Entry("invalid character", "wde_01JBRQS1J5A085FYY2M7ZU", ulid.ErrInvalidCharacters),
+		Entry("overflow", "wde_81JBRQS1J5A085FYY2M7ZT", ulid.ErrOverflow),
+		Entry("suffix bits of other kind", "wde_01JBRQS1J5A085FYY2M7Z0", sdulid.ErrInvalidSuffix),
+		Entry("wrong prefix", "owd_01JBRQS1J5A085FYY2M7ZT", sdulid.ErrWrongKind),
+		Entry("long form of other kind", "01JBRQS1J5A085FYY2M7ZXW001", sdulid.ErrInvalidSuffix),
+	)
+
+	It("should value and scan", func() {
+		val, err := id1.Value()
+		Expect(err).ToNot(HaveOccurred())
+
+		var id2 sdulid.ID24[wideID]
+		Expect(id2.Scan(val)).To(Succeed())
+		Expect(id2).To(Equal(id1))
+		Expect(id2.Scan("wde_01JBRQS1J5A085FYY2M7ZT")).To(Succeed())
+		Expect(id2).To(Equal(id1))
+
+		var id3 sdulid.ID24[otherWideID]
+		Expect(id3.Scan(val)).To(MatchError(sdulid.ErrInvalidSuffix))
+		Expect(id3.Scan(42)).To(MatchError(sdulid.ErrScanValue))
+	})
+
+	It("should panic on kind numbers that don't fit", func() {
+		Expect(func() { sdulid.Make24[tooWideID]() }).To(PanicWith(ContainSubstring("does not fit in 3 bytes")))
+	})
+
+	It("should check the kind when unmarshaling binary", func() {
+		var buf bytes.Buffer
+		Expect(gob.NewEncoder(&buf).Encode(id1)).To(Succeed())
+
+		var id2 sdulid.ID24[wideID]
+		Expect(gob.NewDecoder(bytes.NewReader(buf.Bytes())).Decode(&id2)).To(Succeed())
+		Expect(id2).To(Equal(id1))
+
+		var id3 sdulid.ID24[otherWideID]
+		Expect(gob.NewDecoder(&buf).Decode(&id3)).To(MatchError(sdulid.ErrInvalidSuffix))
+		Expect(id3.UnmarshalBinary(id1.Bytes()[:15])).To(MatchError(ulid.ErrDataSize))
+	})
+
+	It("should generate domain sql", func() {
+		Expect(sdulid.CreateDomainSQL24[wideID]()).To(ContainSubstring(
+			"get_byte(VALUE, 13) = 171 AND \n\t\t\tget_byte(VALUE, 14) = 205 AND \n\t\t\tget_byte(VALUE, 15) = 239"))
+	})
+})
@@ -0,0 +1,204 @@
+package sdulid
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// KindNarrow describes a kind of which the number fits in a single byte, see ID8.
+type KindNarrow interface {
+	KindNumber() uint8
+	KindIdent() string
+	KindShortIdent() string
+}
+
+// textSize8 is the size of the text form of an ID8 without the prefix: a ulid without the last character.
+const textSize8 = ulid.EncodedSize - 1
+
+// ID8 is a self-describing ULID that only reserves the last byte for the kind, for applications
+// (e.g. on embedded devices) with less than 256 kinds. This leaves 72 bits of entropy instead of 64.
+// The text form is the short ident, an underscore and 25 characters: e.g. "nrw_01JBRQS1J5A085FYY2M7ZXWG6".
+type ID8[T KindNarrow] struct {
+	ulid.ULID
+}
+
+// New8 is like New but for kinds with a 1-byte kind number.
+func New8[T KindNarrow](opts ...Option) (id ID8[T], err error) {
+	id.ULID, err = newULID(opts)
+	if err != nil {
+		return id, err
+	}
+
+	id.putSuffixBytes()
+
+	return id, nil
+}
+
+// Make8 is like Make but for kinds with a 1-byte kind number.
+func Make8[T KindNarrow](opts ...Option) ID8[T] {
+	id, err := New8[T](opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
+
+// FromULID8 is like FromULID but for kinds with a 1-byte kind number.
+func FromULID8[T KindNarrow](s string) (id ID8[T], err error) {
+	id.ULID, err = parseULID(s)
+	if err != nil {
+		return id, fmt.Errorf("failed to parse ulid: %w", err)
+	}
+
+	id.putSuffixBytes()
+
+	return
+}
+
+// Parse8 parses the prefixed or long text form into an ID8 of kind T.
+func Parse8[T KindNarrow](s string) (id ID8[T], err error) {
+	err = id.UnmarshalText([]byte(s))
+
+	return id, err
+}
+
+// MustParse8 is like Parse8 but panics on error.
+func MustParse8[T KindNarrow](s string) ID8[T] {
+	id, err := Parse8[T](s)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
+
+func (id *ID8[T]) putSuffixBytes() {
+	var kind T
+	id.ULID[15] = kind.KindNumber()
+}
+
+// EncodedSize returns the size of the prefixed text form.
+func (id ID8[T]) EncodedSize() int {
+	var kind T
+
+	return len(kind.KindShortIdent()) + 1 + textSize8
+}
+
+// String returns the prefixed text form.
+func (id ID8[T]) String() string {
+	var buf [stringBufSize]byte
+	d, _ := id.AppendText(buf[:0])
+
+	return string(d)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface by returning the prefixed text form.
+func (id ID8[T]) MarshalText() ([]byte, error) {
+	return id.AppendText(make([]byte, 0, id.EncodedSize()))
+}
+
+// AppendText implements the encoding.TextAppender interface by appending the prefixed text form to dst.
+func (id ID8[T]) AppendText(dst []byte) ([]byte, error) {
+	n := len(dst)
+	dst = append(dst, make([]byte, id.EncodedSize())...)
+	encodeTextOf[T](dst[n:], id.ULID)
+
+	// the 25th character holds the last two bits of entropy and the upper 3 bits of the kind.
+	dst[len(dst)-1] = ulid.Encoding[((id.ULID[14]&3)<<3)|((id.ULID[15]&224)>>5)]
+
+	return dst, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It accepts the prefixed and the
+// long text form and checks that the text describes kind T.
+func (id *ID8[T]) UnmarshalText(v []byte) error {
+	var kind T
+
+	shortIdent := kind.KindShortIdent()
+	if len(v) > len(shortIdent) && string(v[:len(shortIdent)]) == shortIdent && v[len(shortIdent)] == '_' {
+		return decodeText8(&id.ULID, v[len(shortIdent)+1:], kind.KindNumber())
+	} else if i := bytes.IndexByte(v, '_'); i >= 0 {
+		return &WrongKindError{Expected: shortIdent, Actual: string(v[:i])}
+	} else if len(v) != ulid.EncodedSize {
+		return ErrNoPrefix
+	}
+
+	if err := decodeLong(&id.ULID, v); err != nil {
+		return err
+	}
+
+	if id.ULID[15] != kind.KindNumber() {
+		return ErrInvalidSuffix
+	}
+
+	return nil
+}
+
+// Value implements the sql/driver.Valuer interface by returning the 16 raw bytes.
+func (id ID8[T]) Value() (driver.Value, error) {
+	return id.ULID.Bytes(), nil
+}
+
+// Scan implements the sql.Scanner interface. Like ID it accepts the raw bytes and both text forms,
+// the last byte is checked to describe T.
+func (id *ID8[T]) Scan(src any) error {
+	var b []byte
+
+	switch x := src.(type) {
+	case nil:
+		return nil
+	case string:
+		return id.UnmarshalText([]byte(x))
+	case []byte:
+		if len(x) != len(id.ULID) {
+			return id.UnmarshalText(x)
+		}
+
+		b = x
+	case [16]byte:
+		b = x[:]
+	default:
+		return ErrScanValue
+	}
+
+	var kind T
+	if b[15] != kind.KindNumber() {
+		return ErrInvalidSuffix
+	}
+
+	copy(id.ULID[:], b)
+
+	return nil
+}
+
+// decodeText8 decodes the 25 characters of the ID8 text form (without prefix) into dst and sets the
+// kind byte. The last character carries the upper 3 bits of the kind, they must match.
+func decodeText8(dst *ulid.ULID, v []byte, kindNumber uint8) error {
+	if len(v) != textSize8 {
+		return ulid.ErrDataSize
+	}
+
+	dec := decoder()
+	if err := checkText(dec, v); err != nil {
+		return err
+	}
+
+	last := dec[v[24]]
+	if last == 0xFF {
+		return ulid.ErrInvalidCharacters
+	}
+
+	if last&7 != kindNumber>>5 {
+		return ErrInvalidSuffix
+	}
+
+	decodeTimeAndEntropy(dst, dec, v)
+	dst[14] = (dec[v[22]] << 7) | (dec[v[23]] << 2) | (last >> 3)
+	dst[15] = kindNumber
+
+	return nil
+}
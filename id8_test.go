@@ -0,0 +1,107 @@
+package sdulid_test
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/advdv/sdulid"
+	"github.com/oklog/ulid/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type narrowID struct{}
+
+func (narrowID) KindNumber() uint8      { return 200 }
+func (narrowID) KindIdent() string      { return "narrow" }
+func (narrowID) KindShortIdent() string { return "nrw" }
+
+type otherNarrowID struct{}
+
+func (otherNarrowID) KindNumber() uint8      { return 1 }
+func (otherNarrowID) KindIdent() string      { return "other_narrow" }
+func (otherNarrowID) KindShortIdent() string { return "onr" }
+
+var _ = Describe("id8", func() {
+	var id1 sdulid.ID8[narrowID]
+
+	BeforeEach(func() {
+		var err error
+		id1, err = sdulid.FromULID8[narrowID]("01JBRQS1J5A085FYY2M7ZXWG00")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should only reserve the last byte", func() {
+		Expect(id1.Bytes()).To(Equal([]byte{1, 146, 241, 124, 134, 69, 80, 16, 87, 251, 194, 161, 255, 222, 64, 200}))
+	})
+
+	It("should round trip the text form", func() {
+		Expect(id1.String()).To(Equal("nrw_01JBRQS1J5A085FYY2M7ZXWG6"))
+		Expect(id1.EncodedSize()).To(Equal(len("nrw_01JBRQS1J5A085FYY2M7ZXWG6")))
+		Expect(sdulid.MustParse8[narrowID]("nrw_01JBRQS1J5A085FYY2M7ZXWG6")).To(Equal(id1))
+		Expect(sdulid.MustParse8[narrowID](id1.ULID.String())).To(Equal(id1))
+
+		dst, err := id1.AppendText([]byte("x"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(dst)).To(Equal("xnrw_01JBRQS1J5A085FYY2M7ZXWG6"))
+	})
+
+	It("should keep the entropy of the 25th character", func() {
+		id2 := sdulid.Make8[narrowID]()
+		for range 100 {
+			Expect(sdulid.MustParse8[narrowID](id2.String())).To(Equal(id2))
+			id2 = sdulid.Make8[narrowID]()
+		}
+
+		other := sdulid.Make8[otherNarrowID]()
+		Expect(sdulid.MustParse8[otherNarrowID](other.String())).To(Equal(other))
+	})
+
+	DescribeTable("invalid text",
+		func(s string, expErr error) {
+			_, err := sdulid.Parse8[narrowID](s)
+			Expect(err).To(MatchError(expErr))
+		},
+		Entry("too short", "nrw_01JBRQS1J5A085FYY2M7ZXW0", ulid.ErrDataSize),
+		Entry("invalid last character", "nrw_01JBRQS1J5A085FYY2M7ZXW0U", ulid.ErrInvalidCharacters),
+		Entry("suffix bits of other kind", "nrw_01JBRQS1J5A085FYY2M7ZXW00", sdulid.ErrInvalidSuffix),
+		Entry("wrong prefix", "onr_01JBRQS1J5A085FYY2M7ZXWG6", sdulid.ErrWrongKind),
+		Entry("long form of other kind", "01JBRQS1J5A085FYY2M7ZXW001", sdulid.ErrInvalidSuffix),
+		Entry("no prefix", "01JBRQS1J5A085FYY2M7ZXW06", sdulid.ErrNoPrefix),
+	)
+
+	It("should value and scan", func() {
+		val, err := id1.Value()
+		Expect(err).ToNot(HaveOccurred())
+
+		var id2 sdulid.ID8[narrowID]
+		Expect(id2.Scan(val)).To(Succeed())
+		Expect(id2).To(Equal(id1))
+		Expect(id2.Scan([16]byte(id1.ULID))).To(Succeed())
+		Expect(id2.Scan("nrw_01JBRQS1J5A085FYY2M7ZXWG6")).To(Succeed())
+		Expect(id2).To(Equal(id1))
+
+		var id3 sdulid.ID8[otherNarrowID]
+		Expect(id3.Scan(val)).To(MatchError(sdulid.ErrInvalidSuffix))
+		Expect(id3.Scan(42)).To(MatchError(sdulid.ErrScanValue))
+		Expect(id3.Scan(nil)).To(Succeed())
+	})
+
+	It("should check the kind when unmarshaling binary", func() {
+		var buf bytes.Buffer
+		Expect(gob.NewEncoder(&buf).Encode(id1)).To(Succeed())
+
+		var id2 sdulid.ID8[narrowID]
+		Expect(gob.NewDecoder(bytes.NewReader(buf.Bytes())).Decode(&id2)).To(Succeed())
+		Expect(id2).To(Equal(id1))
+
+		var id3 sdulid.ID8[otherNarrowID]
+		Expect(gob.NewDecoder(&buf).Decode(&id3)).To(MatchError(sdulid.ErrInvalidSuffix))
+		Expect(id3.UnmarshalBinary(id1.Bytes()[:15])).To(MatchError(ulid.ErrDataSize))
+	})
+
+	It("should generate domain sql", func() {
+		Expect(sdulid.CreateDomainSQL8[narrowID]()).To(ContainSubstring(
+			"octet_length(VALUE) = 16 AND \n\t\t\tget_byte(VALUE, 15) = 200\n"))
+	})
+})
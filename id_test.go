@@ -1,9 +1,13 @@
 package sdulid_test
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/advdv/sdulid"
 	"github.com/oklog/ulid/v2"
@@ -23,6 +27,12 @@ func (testID) KindNumber() uint16     { return math.MaxUint16 }
 func (testID) KindIdent() string      { return "test" }
 func (testID) KindShortIdent() string { return "tst" }
 
+type otherID struct{}
+
+func (otherID) KindNumber() uint16     { return 1 }
+func (otherID) KindIdent() string      { return "other" }
+func (otherID) KindShortIdent() string { return "oth" }
+
 var _ = Describe("model id", func() {
 	var id1 sdulid.ID[testID]
 
@@ -39,6 +49,84 @@ var _ = Describe("model id", func() {
 		Expect(id1.Bytes()[14:]).To(Equal([]byte{255, 255}))
 	})
 
+	Describe("comparison", func() {
+		It("should compare, order and equal", func() {
+			id0, id2 := sdulid.Zero[testID](), sdulid.Max[testID]()
+			Expect(id0.Compare(id1)).To(Equal(-1))
+			Expect(id2.Compare(id1)).To(Equal(1))
+			Expect(id1.Compare(id1)).To(Equal(0))
+			Expect(id0.Less(id1)).To(BeTrue())
+			Expect(id1.Less(id0)).To(BeFalse())
+			Expect(id1.Equal(sdulid.MustParse[testID]("tst_01JBRQS1J5A085FYY2M7ZXXZ"))).To(BeTrue())
+			Expect(id1.Equal(id2)).To(BeFalse())
+			Expect(sdulid.EqualConstantTime(id1, sdulid.MustParse[testID]("tst_01JBRQS1J5A085FYY2M7ZXXZ"))).To(BeTrue())
+			Expect(sdulid.EqualConstantTime(id1, id2)).To(BeFalse())
+		})
+
+		It("should sort and binary search", func() {
+			ids := []sdulid.ID[testID]{sdulid.Max[testID](), id1, sdulid.Zero[testID]()}
+			slices.SortFunc(ids, sdulid.CompareFunc[testID]())
+			Expect(ids).To(Equal([]sdulid.ID[testID]{sdulid.Zero[testID](), id1, sdulid.Max[testID]()}))
+
+			idx, found := slices.BinarySearchFunc(ids, id1, sdulid.CompareFunc[testID]())
+			Expect(found).To(BeTrue())
+			Expect(idx).To(Equal(1))
+		})
+	})
+
+	Describe("zero and max", func() {
+		It("should construct zero", func() {
+			id1 := sdulid.Zero[testID]()
+			Expect(id1.Bytes()).To(Equal([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 255, 255}))
+			Expect(id1.IsZero()).To(BeTrue())
+			Expect(id1.String()).To(Equal("tst_00000000000000000000001Z"))
+		})
+
+		It("should construct max", func() {
+			id1 := sdulid.Max[otherID]()
+			Expect(id1.Bytes()).To(Equal([]byte{255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 0, 1}))
+			Expect(id1.IsZero()).To(BeFalse())
+			Expect(id1.String()).To(Equal("oth_7ZZZZZZZZZZZZZZZZZZZZZY0"))
+		})
+
+		It("should consider zero value and made ids", func() {
+			var id1 sdulid.ID[testID]
+			Expect(id1.IsZero()).To(BeTrue())
+			Expect(sdulid.Make[testID]().IsZero()).To(BeFalse())
+		})
+	})
+
+	Describe("make options", func() {
+		It("should make at a given time", func() {
+			at := time.Date(2024, 11, 3, 10, 0, 0, 0, time.UTC)
+			id1 := sdulid.MakeAt[testID](at)
+			Expect(ulid.Time(id1.ULID.Time())).To(BeTemporally("==", at))
+			Expect(id1.Bytes()[14:]).To(Equal([]byte{255, 255}))
+		})
+
+		It("should make with timestamp and entropy", func() {
+			id1 := sdulid.Make[testID](
+				sdulid.WithTimestampMS(1730628000000),
+				sdulid.WithEntropy(bytes.NewReader(bytes.Repeat([]byte{1}, 10))))
+			Expect(id1.ULID.Time()).To(Equal(uint64(1730628000000)))
+			Expect(id1.Bytes()[6:]).To(Equal([]byte{1, 1, 1, 1, 1, 1, 1, 1, 255, 255}))
+		})
+
+		It("should error on too large timestamp", func() {
+			_, err := sdulid.New[testID](sdulid.WithTimestampMS(ulid.MaxTime() + 1))
+			Expect(err).To(MatchError(ulid.ErrBigTime))
+
+			Expect(func() {
+				sdulid.Make[testID](sdulid.WithTimestampMS(ulid.MaxTime() + 1))
+			}).To(PanicWith(MatchError(ulid.ErrBigTime)))
+		})
+
+		It("should error on failing entropy", func() {
+			_, err := sdulid.New[testID](sdulid.WithEntropy(bytes.NewReader(nil)))
+			Expect(err).To(MatchError(ContainSubstring("failed to generate ulid")))
+		})
+	})
+
 	Describe("text encoding", func() {
 		It("should error on wrong buffer size when marshaling text", func() {
 			var dst []byte
@@ -51,12 +139,36 @@ var _ = Describe("model id", func() {
 			Expect(string(dst)).To(Equal(`tst_01JBRQS1J5A085FYY2M7ZXXZ`))
 		})
 
+		It("should put text into the start of larger buffers", func() {
+			dst := bytes.Repeat([]byte{'.'}, 32)
+			n, err := id1.PutText(dst)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(id1.EncodedSize()))
+			Expect(string(dst)).To(Equal(`tst_01JBRQS1J5A085FYY2M7ZXXZ....`))
+
+			n, err = id1.PutText(dst[:n-1])
+			Expect(err).To(MatchError(sdulid.ErrBufferSize))
+			Expect(n).To(BeZero())
+		})
+
 		It("should marshal text", func() {
 			dst, err := id1.MarshalText()
 			Expect(err).ToNot(HaveOccurred())
 			Expect(string(dst)).To(Equal(`tst_01JBRQS1J5A085FYY2M7ZXXZ`))
 		})
 
+		It("should append text", func() {
+			dst, err := id1.AppendText([]byte("id="))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(dst)).To(Equal(`id=tst_01JBRQS1J5A085FYY2M7ZXXZ`))
+		})
+
+		It("should append binary", func() {
+			dst, err := id1.AppendBinary([]byte{42})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dst).To(Equal([]byte{42, 1, 146, 241, 124, 134, 69, 80, 16, 87, 251, 194, 161, 255, 222, 255, 255}))
+		})
+
 		It("should prefix with short ident for stringer", func() {
 			Expect(id1.String()).To(Equal("tst_01JBRQS1J5A085FYY2M7ZXXZ"))
 		})
@@ -83,6 +195,106 @@ var _ = Describe("model id", func() {
 			Expect(id2.UnmarshalText([]byte("01JBRQS1J5A085FYY2M7ZXXZZE"))).To(MatchError(sdulid.ErrInvalidSuffix))
 		})
 
+		It("should decode kinds with any suffix", func() {
+			id2 := sdulid.MustFromULID[otherID]("01JBRQS1J5A085FYY2M7ZXWG00")
+			Expect(id2.String()).To(Equal("oth_01JBRQS1J5A085FYY2M7ZXW0"))
+
+			var id3 sdulid.ID[otherID]
+			Expect(id3.UnmarshalText([]byte(id2.String()))).To(Succeed())
+			Expect(id3).To(Equal(id2))
+		})
+
+		It("should decode lower case", func() {
+			var id2 sdulid.ID[testID]
+			Expect(id2.UnmarshalText([]byte("tst_01jbrqs1j5a085fyy2m7zxxz"))).To(Succeed())
+			Expect(id2).To(Equal(id1))
+		})
+
+		It("should decode confusables in lenient mode", func() {
+			var id2 sdulid.ID[testID]
+			Expect(id2.UnmarshalText([]byte("tst_OIJBRQSLJ5A085FYY2M7ZXXZ"))).To(MatchError(ulid.ErrInvalidCharacters))
+
+			sdulid.SetParseMode(sdulid.ParseModeLenient)
+			DeferCleanup(sdulid.SetParseMode, sdulid.ParseModeDefault)
+
+			Expect(id2.UnmarshalText([]byte("tst_OIJBRQSLJ5A085FYY2M7ZXXZ"))).To(Succeed())
+			Expect(id2).To(Equal(id1))
+			Expect(id2.UnmarshalText([]byte("tst_oijbrqslj5a085fyy2m7zxxz"))).To(Succeed())
+			Expect(id2).To(Equal(id1))
+			Expect(id2.UnmarshalText([]byte("oIJBRQSLJ5A085FYY2M7ZXXZZZ"))).To(Succeed())
+			Expect(id2).To(Equal(id1))
+
+			Expect(id2.UnmarshalText([]byte("tst_U1JBRQS1J5A085FYY2M7ZXXZ"))).To(MatchError(ulid.ErrInvalidCharacters))
+			Expect(id2.UnmarshalText([]byte("U1JBRQS1J5A085FYY2M7ZXXZZZ"))).To(MatchError(ulid.ErrInvalidCharacters))
+		})
+
+		It("should only decode upper case in strict mode", func() {
+			sdulid.SetParseMode(sdulid.ParseModeStrict)
+			DeferCleanup(sdulid.SetParseMode, sdulid.ParseModeDefault)
+
+			var id2 sdulid.ID[testID]
+			Expect(id2.UnmarshalText([]byte("tst_01JBRQS1J5A085FYY2M7ZXXZ"))).To(Succeed())
+			Expect(id2.UnmarshalText([]byte("tst_01jbrqs1j5a085fyy2m7zxxz"))).To(MatchError(ulid.ErrInvalidCharacters))
+			Expect(id2.UnmarshalText([]byte("01jbrqs1j5a085fyy2m7zxxzzz"))).To(MatchError(ulid.ErrInvalidCharacters))
+			Expect(id2.UnmarshalText([]byte("tst_OIJBRQSLJ5A085FYY2M7ZXXZ"))).To(MatchError(ulid.ErrInvalidCharacters))
+			Expect(id2.UnmarshalText([]byte("tst_z1JBRQS1J5A085FYY2M7ZXXZ"))).To(MatchError(ulid.ErrInvalidCharacters))
+		})
+
+		DescribeTable("invalid prefixed format",
+			func(s string, expErr error) {
+				var id2 sdulid.ID[testID]
+				Expect(id2.UnmarshalText([]byte(s))).To(MatchError(expErr))
+			},
+			Entry("too short", "tst_01JBRQS1J5A085FYY2M7ZXX", ulid.ErrDataSize),
+			Entry("invalid character", "tst_01JBRQS1J5A085FYY2M7ZXUZ", ulid.ErrInvalidCharacters),
+			Entry("overflow", "tst_81JBRQS1J5A085FYY2M7ZXXZ", ulid.ErrOverflow),
+			Entry("suffix bits of other kind", "tst_01JBRQS1J5A085FYY2M7ZXW0", sdulid.ErrInvalidSuffix),
+			Entry("prefix not at start", "xtst_01JBRQS1J5A085FYY2M7ZXXZ", sdulid.ErrWrongKind),
+		)
+
+		DescribeTable("invalid long format",
+			func(s string, expErr error) {
+				var id2 sdulid.ID[testID]
+				Expect(id2.UnmarshalText([]byte(s))).To(MatchError(expErr))
+			},
+			Entry("invalid character", "01JBRQS1J5A085FYY2M7ZUXZZZ", ulid.ErrInvalidCharacters),
+			Entry("invalid symbol", "01JBRQS1J5A085FYY2M7Z*XZZZ", ulid.ErrInvalidCharacters),
+			Entry("overflow", "81JBRQS1J5A085FYY2M7ZXXZZZ", ulid.ErrOverflow),
+			Entry("max overflow", "ZZZZZZZZZZZZZZZZZZZZZZZZZZ", ulid.ErrOverflow),
+		)
+
+		It("should only reject invalid ulid characters in strict mode", func() {
+			_, err := sdulid.FromULID[testID]("01JBRQS1J5A085FYY2M7ZUXZZZ")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = sdulid.FromULID[testID]("81JBRQS1J5A085FYY2M7ZXXZZZ")
+			Expect(err).To(MatchError(ulid.ErrOverflow))
+
+			sdulid.SetParseMode(sdulid.ParseModeStrict)
+			DeferCleanup(sdulid.SetParseMode, sdulid.ParseModeDefault)
+
+			_, err = sdulid.FromULID[testID]("01JBRQS1J5A085FYY2M7ZUXZZZ")
+			Expect(err).To(MatchError(ulid.ErrInvalidCharacters))
+			_, err = sdulid.FromULID8[narrowID]("01JBRQS1J5A085FYY2M7Z*XZZZ")
+			Expect(err).To(MatchError(ulid.ErrInvalidCharacters))
+			_, err = sdulid.FromULID24[wideID]("01JBRQS1J5A085FYY2M7ZUXZZZ")
+			Expect(err).To(MatchError(ulid.ErrInvalidCharacters))
+			_, err = sdulid.FromULID[testID]("ZZZZZZZZZZZZZZZZZZZZZZZZZZ")
+			Expect(err).To(MatchError(ulid.ErrOverflow))
+		})
+
+		It("should report wrong kind", func() {
+			var id2 sdulid.ID[testID]
+			err := id2.UnmarshalText([]byte("oth_01JBRQS1J5A085FYY2M7ZXW0"))
+			Expect(err).To(MatchError(sdulid.ErrWrongKind))
+			Expect(err).To(MatchError(`sdulid: failed to parse "oth_01JBRQS1J5A085FYY2M7ZXW0" at offset 0: ` +
+				`sdulid: wrong kind: expected prefix "tst", got "oth"`))
+
+			var wkerr *sdulid.WrongKindError
+			Expect(errors.As(err, &wkerr)).To(BeTrue())
+			Expect(wkerr.Expected).To(Equal("tst"))
+			Expect(wkerr.Actual).To(Equal("oth"))
+		})
+
 		It("should not decode without prefix and short format", func() {
 			var id2 sdulid.ID[testID]
 			Expect(id2.UnmarshalText([]byte("01JBRQS1J5A085FYY2M7ZXXZ"))).To(MatchError(sdulid.ErrNoPrefix))
@@ -92,6 +304,24 @@ var _ = Describe("model id", func() {
 	It("should", func() {
 	})
 
+	Describe("parse", func() {
+		It("should parse prefixed and long form", func() {
+			id2, err := sdulid.Parse[testID]("tst_01JBRQS1J5A085FYY2M7ZXXZ")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(id2).To(Equal(id1))
+			Expect(sdulid.MustParse[testID]("01JBRQS1J5A085FYY2M7ZXXZZZ")).To(Equal(id1))
+		})
+
+		It("should error or panic on invalid input", func() {
+			_, err := sdulid.Parse[testID]("01JBRQS1J5A085FYY2M7ZXXZ")
+			Expect(err).To(MatchError(sdulid.ErrNoPrefix))
+
+			Expect(func() {
+				sdulid.MustParse[testID]("01JBRQS1J5A085FYY2M7ZXXZZE")
+			}).To(PanicWith(MatchError(sdulid.ErrInvalidSuffix)))
+		})
+	})
+
 	It("should fail from invalid ulid", func() {
 		Expect(func() {
 			sdulid.MustFromULID[testID]("0")
@@ -0,0 +1,76 @@
+package sdulid
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// ErrInvalidKSUID is returned when a string is not a valid KSUID.
+var ErrInvalidKSUID = errors.New("sdulid: invalid ksuid")
+
+const (
+	// ksuidEpoch is the Unix time (in seconds) that KSUID timestamps are relative to.
+	ksuidEpoch = 1400000000
+	// ksuidEncodedSize is the size of the base62 text form of a KSUID.
+	ksuidEncodedSize = 27
+	// ksuidSize is the size of the binary form of a KSUID: a 4-byte timestamp and a 16-byte payload.
+	ksuidSize = 20
+	// ksuidAlphabet is the base62 alphabet of the KSUID text form.
+	ksuidAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// FromKSUID converts a KSUID in its text form into an ID of kind T, for migrating legacy datasets. The
+// timestamp of the KSUID is carried over (KSUIDs have second precision) and the payload is hashed into
+// the entropy, so the same KSUID always converts into the same ID. The conversion is one-way.
+func FromKSUID[T Kind](s string) (id ID[T], err error) {
+	if len(s) != ksuidEncodedSize {
+		return id, ErrInvalidKSUID
+	}
+
+	n := new(big.Int)
+	for i := range len(s) {
+		d := strings.IndexByte(ksuidAlphabet, s[i])
+		if d < 0 {
+			return id, ErrInvalidKSUID
+		}
+
+		n.Mul(n, big.NewInt(int64(len(ksuidAlphabet)))).Add(n, big.NewInt(int64(d)))
+	}
+
+	if n.BitLen() > ksuidSize*8 {
+		return id, ErrInvalidKSUID
+	}
+
+	var b [ksuidSize]byte
+	n.FillBytes(b[:])
+
+	secs := int64(b[0])<<24 | int64(b[1])<<16 | int64(b[2])<<8 | int64(b[3])
+
+	return fromForeign[T](time.Unix(ksuidEpoch+secs, 0), b[4:])
+}
+
+// FromOpaqueString converts a foreign ID without an embedded timestamp (e.g. Stripe-style IDs such as
+// "cus_NffrFeUfNV2Hib") into an ID of kind T. The string is hashed into the entropy so the same string
+// always converts into the same ID. Since the string holds no time, the timestamp is taken from t which
+// would typically be the creation time of the record.
+func FromOpaqueString[T Kind](s string, t time.Time) (ID[T], error) {
+	return fromForeign[T](t, []byte(s))
+}
+
+// fromForeign creates an ID with the timestamp of t and entropy derived from payload.
+func fromForeign[T Kind](t time.Time, payload []byte) (id ID[T], err error) {
+	if err := id.ULID.SetTime(ulid.Timestamp(t)); err != nil {
+		return id, err //nolint:wrapcheck
+	}
+
+	sum := sha256.Sum256(payload)
+	copy(id.ULID[6:14], sum[:])
+	id.putSuffixBytes()
+
+	return id, nil
+}
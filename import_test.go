@@ -0,0 +1,47 @@
+package sdulid_test
+
+import (
+	"time"
+
+	"github.com/advdv/sdulid"
+	"github.com/oklog/ulid/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("import", func() {
+	It("should convert ksuids", func() {
+		id1, err := sdulid.FromKSUID[testID]("0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(id1.Time().UTC()).To(Equal(time.Date(2017, 10, 10, 4, 0, 47, 0, time.UTC)))
+		Expect(id1.Bytes()[14:]).To(Equal([]byte{255, 255}))
+		Expect(sdulid.FromKSUID[testID]("0ujtsYcgvSTl8PAuAdqWYSMnLOv")).To(Equal(id1))
+
+		id2, err := sdulid.FromKSUID[testID]("0ujsswThIGTUYm2K8FjOOfXtY1K")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(id2).ToNot(Equal(id1))
+	})
+
+	DescribeTable("invalid ksuids",
+		func(s string) {
+			_, err := sdulid.FromKSUID[testID](s)
+			Expect(err).To(MatchError(sdulid.ErrInvalidKSUID))
+		},
+		Entry("too short", "0ujtsYcgvSTl8PAuAdqWYSMnLO"),
+		Entry("invalid character", "0ujtsYcgvSTl8PAuAdqWYSMnL_v"),
+		Entry("overflow", "zzzzzzzzzzzzzzzzzzzzzzzzzzz"),
+	)
+
+	It("should convert opaque strings", func() {
+		t := time.UnixMilli(1730628322885)
+
+		id1, err := sdulid.FromOpaqueString[testID]("cus_NffrFeUfNV2Hib", t)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(id1.Time()).To(Equal(t))
+		Expect(sdulid.FromOpaqueString[testID]("cus_NffrFeUfNV2Hib", t)).To(Equal(id1))
+		Expect(sdulid.FromOpaqueString[testID]("cus_NffrFeUfNV2Hic", t)).ToNot(Equal(id1))
+
+		_, err = sdulid.FromOpaqueString[testID]("cus_NffrFeUfNV2Hib", time.UnixMilli(int64(ulid.MaxTime())+1))
+		Expect(err).To(MatchError(ulid.ErrBigTime))
+	})
+})
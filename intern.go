@@ -0,0 +1,65 @@
+package sdulid
+
+import (
+	"container/list"
+	"sync"
+)
+
+// DefaultStringCacheSize is the number of strings a StringCache holds when no size is given.
+const DefaultStringCacheSize = 1024
+
+// StringCache interns the text form of IDs of kind T, for workloads that stringify the same IDs over and
+// over (e.g. template rendering or metric labels). It holds at most a fixed number of strings and evicts
+// the least recently used one when full, so memory stays predictable. It is safe for concurrent use.
+type StringCache[T Kind] struct {
+	mu    sync.Mutex
+	size  int
+	lru   *list.List
+	elems map[ID[T]]*list.Element
+}
+
+// stringCacheEntry is the value of each element in the LRU list.
+type stringCacheEntry[T Kind] struct {
+	id ID[T]
+	s  string
+}
+
+// NewStringCache inits a cache that holds at most size strings. When size is zero or negative it
+// defaults to DefaultStringCacheSize.
+func NewStringCache[T Kind](size int) *StringCache[T] {
+	if size <= 0 {
+		size = DefaultStringCacheSize
+	}
+
+	return &StringCache[T]{size: size, lru: list.New(), elems: make(map[ID[T]]*list.Element, size)}
+}
+
+// String returns the text form of id, the same string is returned for as long as id stays cached.
+func (c *StringCache[T]) String(id ID[T]) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.elems[id]; ok {
+		c.lru.MoveToFront(elem)
+
+		return elem.Value.(*stringCacheEntry[T]).s //nolint:forcetypeassert
+	}
+
+	entry := &stringCacheEntry[T]{id: id, s: id.String()}
+	if c.lru.Len() >= c.size {
+		oldest := c.lru.Back()
+		delete(c.elems, c.lru.Remove(oldest).(*stringCacheEntry[T]).id) //nolint:forcetypeassert
+	}
+
+	c.elems[id] = c.lru.PushFront(entry)
+
+	return entry.s
+}
+
+// Len returns the number of cached strings.
+func (c *StringCache[T]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
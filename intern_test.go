@@ -0,0 +1,44 @@
+package sdulid_test
+
+import (
+	"unsafe"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("string cache", func() {
+	It("should return the interned string", func() {
+		cache := sdulid.NewStringCache[testID](2)
+		id := sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+
+		s1, s2 := cache.String(id), cache.String(id)
+		Expect(s1).To(Equal("tst_01JBRQS1J5A085FYY2M7ZXXZ"))
+		Expect(unsafe.StringData(s1)).To(Equal(unsafe.StringData(s2)))
+		Expect(cache.Len()).To(Equal(1))
+	})
+
+	It("should evict the least recently used", func() {
+		cache := sdulid.NewStringCache[testID](2)
+		id1, id2, id3 := sdulid.Make[testID](), sdulid.Make[testID](), sdulid.Make[testID]()
+
+		s1 := cache.String(id1)
+		cache.String(id2)
+		Expect(unsafe.StringData(cache.String(id1))).To(Equal(unsafe.StringData(s1)))
+
+		cache.String(id3) // evicts id2
+		Expect(cache.Len()).To(Equal(2))
+		Expect(unsafe.StringData(cache.String(id1))).To(Equal(unsafe.StringData(s1)))
+		Expect(cache.String(id2)).To(Equal(id2.String()))
+	})
+
+	It("should default the size", func() {
+		cache := sdulid.NewStringCache[testID](0)
+		for range sdulid.DefaultStringCacheSize + 10 {
+			cache.String(sdulid.Make[testID]())
+		}
+
+		Expect(cache.Len()).To(Equal(sdulid.DefaultStringCacheSize))
+	})
+})
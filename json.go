@@ -0,0 +1,73 @@
+package sdulid
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// JSONForm determines the text form that is used when ID values are encoded as JSON.
+type JSONForm int32
+
+const (
+	// JSONFormPrefixed encodes IDs in the prefixed short form, e.g: "tst_01JBRQS1J5A085FYY2M7ZXXZ".
+	JSONFormPrefixed JSONForm = iota
+	// JSONFormLong encodes IDs as the bare 26 character ULID, e.g: "01JBRQS1J5A085FYY2M7ZXXZZZ". This
+	// is useful for legacy consumers that can't handle the prefix.
+	JSONFormLong
+)
+
+// ErrInvalidJSON is returned when JSON decoding is provided with something other than a JSON string.
+var ErrInvalidJSON = errors.New("sdulid: JSON value must be a string")
+
+// jsonForm holds the package-wide JSON form.
+var jsonForm atomic.Int32
+
+// SetJSONForm configures the form in which all IDs are encoded as JSON. Decoding always
+// accepts both forms. It is safe for concurrent use but is meant to be called once during
+// program initialization.
+func SetJSONForm(f JSONForm) {
+	jsonForm.Store(int32(f))
+}
+
+// GetJSONForm returns the currently configured JSON form.
+func GetJSONForm() JSONForm {
+	return JSONForm(jsonForm.Load())
+}
+
+// MarshalJSON implements the json.Marshaler interface by encoding the ID as a JSON string in the
+// form that is configured through SetJSONForm.
+func (id ID[T]) MarshalJSON() ([]byte, error) {
+	if GetJSONForm() == JSONFormLong {
+		return json.Marshal(id.ULID.String()) //nolint:wrapcheck
+	}
+
+	return json.Marshal(id.String()) //nolint:wrapcheck
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts both the prefixed and the
+// long form, escaped characters are decoded like any JSON string. A JSON null leaves the ID untouched.
+func (id *ID[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return ErrInvalidJSON
+	}
+
+	// the text forms never need escaping, so only escaped input takes the slow path.
+	text := data[1 : len(data)-1]
+	if bytes.IndexByte(text, '\\') >= 0 {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return fmt.Errorf("failed to decode JSON string: %w", err)
+		}
+
+		text = []byte(s)
+	}
+
+	return id.UnmarshalText(text)
+}
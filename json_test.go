@@ -0,0 +1,96 @@
+package sdulid_test
+
+import (
+	"encoding/json"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("json", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should implement the interfaces", func() {
+		var _ json.Marshaler = id1
+		var _ json.Unmarshaler = &id1
+	})
+
+	It("should marshal prefixed by default", func() {
+		Expect(sdulid.GetJSONForm()).To(Equal(sdulid.JSONFormPrefixed))
+
+		data, err := json.Marshal(map[string]any{"id": id1})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(`{"id":"tst_01JBRQS1J5A085FYY2M7ZXXZ"}`))
+	})
+
+	It("should marshal long form when configured", func() {
+		sdulid.SetJSONForm(sdulid.JSONFormLong)
+		DeferCleanup(sdulid.SetJSONForm, sdulid.JSONFormPrefixed)
+
+		data, err := json.Marshal(id1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(`"01JBRQS1J5A085FYY2M7ZXXZZZ"`))
+	})
+
+	DescribeTable("unmarshal",
+		func(data string, expErr error) {
+			var v struct {
+				ID sdulid.ID[testID] `json:"id"`
+			}
+
+			err := json.Unmarshal([]byte(data), &v)
+			if expErr != nil {
+				Expect(err).To(MatchError(expErr))
+
+				return
+			}
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(v.ID).To(Equal(id1))
+		},
+		Entry("prefixed", `{"id":"tst_01JBRQS1J5A085FYY2M7ZXXZ"}`, nil),
+		Entry("long", `{"id":"01JBRQS1J5A085FYY2M7ZXXZZZ"}`, nil),
+		Entry("wrong suffix", `{"id":"01JBRQS1J5A085FYY2M7ZXXZZE"}`, sdulid.ErrInvalidSuffix),
+		Entry("not a string", `{"id":42}`, sdulid.ErrInvalidJSON),
+		Entry("escaped", `{"id":"tst\u005f01JBRQS1J5A085FYY2M7ZXXZ"}`, nil),
+		Entry("escaped long", `{"id":"\u0030\u0031JBRQS1J5A085FYY2M7ZXXZZZ"}`, nil),
+		Entry("escaped wrong suffix", `{"id":"01JBRQS1J5A085FYY2M7ZXXZZ\u0045"}`, sdulid.ErrInvalidSuffix),
+	)
+
+	It("should reject invalid escapes", func() {
+		var id2 sdulid.ID[testID]
+		Expect(id2.UnmarshalJSON([]byte(`"tst\x01JBRQS1J5A085FYY2M7ZXXZ"`))).To(
+			MatchError(ContainSubstring("failed to decode JSON string")))
+	})
+
+	It("should round trip ids nested in payloads", func() {
+		type payload struct {
+			ID      sdulid.ID[testID]
+			Parent  *sdulid.ID[testID]
+			Related []sdulid.ID[testID]
+			ByID    map[sdulid.ID[testID]]int
+		}
+
+		in := payload{ID: id1, Parent: &id1, Related: []sdulid.ID[testID]{id1}, ByID: map[sdulid.ID[testID]]int{id1: 1}}
+
+		data, err := json.Marshal(in)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(`{"ID":"tst_01JBRQS1J5A085FYY2M7ZXXZ","Parent":"tst_01JBRQS1J5A085FYY2M7ZXXZ",` +
+			`"Related":["tst_01JBRQS1J5A085FYY2M7ZXXZ"],"ByID":{"tst_01JBRQS1J5A085FYY2M7ZXXZ":1}}`))
+
+		var out payload
+		Expect(json.Unmarshal(data, &out)).To(Succeed())
+		Expect(out).To(Equal(in))
+	})
+
+	It("should leave id untouched on null", func() {
+		id2 := id1
+		Expect(json.Unmarshal([]byte(`null`), &id2)).To(Succeed())
+		Expect(id2).To(Equal(id1))
+	})
+})
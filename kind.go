@@ -0,0 +1,132 @@
+package sdulid
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// ErrInvalidKind is returned when a kind has a short ident or ident that can't be used safely.
+var ErrInvalidKind = errors.New("sdulid: invalid kind")
+
+const (
+	// DefaultMaxShortIdentLength is the default maximum length of short idents.
+	DefaultMaxShortIdentLength = 16
+	// maxIdentLength is the maximum length of an ident, such that the longest name in the DDL
+	// ("generate_{ident}_id") fits in the 63 characters of a Postgres identifier.
+	maxIdentLength = 63 - len("generate__id")
+)
+
+// maxShortIdentLength holds the maximum length of short idents.
+var maxShortIdentLength atomic.Int64
+
+func init() { maxShortIdentLength.Store(DefaultMaxShortIdentLength) }
+
+// SetMaxShortIdentLength configures the maximum length of short idents that ValidateKind accepts. It
+// is safe for concurrent use but is meant to be called once during program initialization.
+func SetMaxShortIdentLength(n int) {
+	maxShortIdentLength.Store(int64(n))
+}
+
+// GetMaxShortIdentLength returns the maximum length of short idents that ValidateKind accepts.
+func GetMaxShortIdentLength() int {
+	return int(maxShortIdentLength.Load())
+}
+
+// KindInfo describes a kind as plain data, e.g. for logging or introspection.
+type KindInfo struct {
+	// Number is the kind number that is stored in the last two bytes.
+	Number uint16
+	// Ident is the (long) identifier of the kind.
+	Ident string
+	// ShortIdent is the identifier that prefixes the text form.
+	ShortIdent string
+	// Prefix is the short ident plus the underscore separator.
+	Prefix string
+}
+
+// KindOf returns the KindInfo of T without needing an ID.
+func KindOf[T Kind]() KindInfo {
+	var kind T
+
+	return KindOfFor(kind)
+}
+
+// KindOfFor is like KindOf but for a kind that is only known at runtime.
+func KindOfFor(kind Kind) KindInfo {
+	return KindInfo{
+		Number:     kind.KindNumber(),
+		Ident:      kind.KindIdent(),
+		ShortIdent: kind.KindShortIdent(),
+		Prefix:     kind.KindShortIdent() + "_",
+	}
+}
+
+// DetectKind identifies the registered kind that the ID in s refers to. Both the prefixed and the
+// long text form are accepted, and s must be a valid ID of that kind.
+func (r *Registry) DetectKind(s string) (KindInfo, error) {
+	_, kind, err := r.ParseAny(s)
+	if err != nil {
+		return KindInfo{}, err
+	}
+
+	return KindOfFor(kind), nil
+}
+
+// ValidateKind checks that the short ident of kind is non-empty, no longer than the configured maximum
+// and only holds lowercase ASCII letters and digits, starting with a letter. It also checks that the
+// ident is a valid SQL identifier, since it names the domain and functions in the DDL. Aliases of an
+// AliasedKind are checked like the short ident. Registries and
+// ValidateKinds check every kind with it.
+func ValidateKind(kind Kind) error {
+	ident := kind.KindIdent()
+	for _, short := range shortIdents(kind) {
+		if err := validateShortIdent(ident, short); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case ident == "":
+		return fmt.Errorf("%w: ident of %q is empty", ErrInvalidKind, kind.KindShortIdent())
+	case len(ident) > maxIdentLength:
+		return fmt.Errorf("%w: ident %q is longer than %d characters", ErrInvalidKind, ident, maxIdentLength)
+	case !isIdent(ident, true):
+		return fmt.Errorf("%w: ident %q is not a valid SQL identifier, it must start with a lowercase "+
+			"letter and only hold lowercase letters (a-z), digits (0-9) and underscores", ErrInvalidKind, ident)
+	}
+
+	return nil
+}
+
+// validateShortIdent checks short, which is the short ident or an alias of the kind with ident.
+func validateShortIdent(ident, short string) error {
+	switch {
+	case short == "":
+		return fmt.Errorf("%w: short ident of %q is empty", ErrInvalidKind, ident)
+	case len(short) > GetMaxShortIdentLength():
+		return fmt.Errorf("%w: short ident %q of %q is longer than %d characters",
+			ErrInvalidKind, short, ident, GetMaxShortIdentLength())
+	case !isIdent(short, false):
+		return fmt.Errorf("%w: short ident %q of %q must start with a lowercase letter and only hold "+
+			"lowercase letters (a-z) and digits (0-9)", ErrInvalidKind, short, ident)
+	}
+
+	return nil
+}
+
+// isIdent reports whether s starts with a lowercase ASCII letter followed by lowercase ASCII letters,
+// digits and, if allowed, underscores.
+func isIdent(s string, underscore bool) bool {
+	for i, c := range []byte(s) {
+		switch {
+		case c >= 'a' && c <= 'z':
+		case i > 0 && c >= '0' && c <= '9':
+		case i > 0 && underscore && c == '_':
+		default:
+			return false
+		}
+	}
+
+	return s != ""
+}
@@ -0,0 +1,81 @@
+package sdulid_test
+
+import (
+	"strings"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// runtimeKind is a kind of which the idents are only known at runtime.
+type runtimeKind struct{ ident, short string }
+
+func (k runtimeKind) KindNumber() uint16     { return 100 }
+func (k runtimeKind) KindIdent() string      { return k.ident }
+func (k runtimeKind) KindShortIdent() string { return k.short }
+
+var _ = Describe("kind info", func() {
+	It("should describe a kind without an id", func() {
+		Expect(sdulid.KindOf[testID]()).To(Equal(sdulid.KindInfo{
+			Number: 65535, Ident: "test", ShortIdent: "tst", Prefix: "tst_",
+		}))
+	})
+
+	DescribeTable("detect kind",
+		func(s string, expIdent string, expErr error) {
+			reg := sdulid.MustNewRegistry(testID{}, otherID{})
+
+			info, err := reg.DetectKind(s)
+			if expErr != nil {
+				Expect(err).To(MatchError(expErr))
+
+				return
+			}
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Ident).To(Equal(expIdent))
+		},
+		Entry("prefixed", "tst_01JBRQS1J5A085FYY2M7ZXXZ", "test", nil),
+		Entry("long", "01JBRQS1J5A085FYY2M7ZXW001", "other", nil),
+		Entry("unknown prefix", "foo_01JBRQS1J5A085FYY2M7ZXXZ", "", sdulid.ErrUnknownKind),
+		Entry("no prefix", "01JBRQS1J5A085FYY2M7ZX", "", sdulid.ErrNoPrefix),
+	)
+
+	DescribeTable("validate kind",
+		func(ident, short string, expErr string) {
+			err := sdulid.ValidateKind(runtimeKind{ident, short})
+			if expErr != "" {
+				Expect(err).To(MatchError(sdulid.ErrInvalidKind))
+				Expect(err).To(MatchError(ContainSubstring(expErr)))
+
+				return
+			}
+
+			Expect(err).ToNot(HaveOccurred())
+		},
+		Entry("valid", "order_item", "oi2", ""),
+		Entry("empty short ident", "order", "", "short ident of \"order\" is empty"),
+		Entry("long short ident", "order", strings.Repeat("o", 17), "longer than 16 characters"),
+		Entry("uppercase short ident", "order", "Ord", "must start with a lowercase letter"),
+		Entry("separator in short ident", "order", "o_d", "must start with a lowercase letter"),
+		Entry("digit first short ident", "order", "1rd", "must start with a lowercase letter"),
+		Entry("empty ident", "", "ord", "ident of \"ord\" is empty"),
+		Entry("long ident", strings.Repeat("o", 52), "ord", "longer than 51 characters"),
+		Entry("invalid sql ident", "order-item", "ord", "not a valid SQL identifier"),
+	)
+
+	It("should configure the maximum short ident length", func() {
+		DeferCleanup(sdulid.SetMaxShortIdentLength, sdulid.GetMaxShortIdentLength())
+
+		sdulid.SetMaxShortIdentLength(2)
+		Expect(sdulid.GetMaxShortIdentLength()).To(Equal(2))
+		Expect(sdulid.ValidateKind(testID{})).To(MatchError(sdulid.ErrInvalidKind))
+		Expect(sdulid.ValidateKind(runtimeKind{"order", "od"})).To(Succeed())
+	})
+
+	It("should refuse invalid kinds in a registry", func() {
+		_, err := sdulid.NewRegistry(testID{}, runtimeKind{"Order", "ord"})
+		Expect(err).To(MatchError(sdulid.ErrInvalidKind))
+	})
+})
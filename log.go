@@ -0,0 +1,67 @@
+package sdulid
+
+import (
+	"log/slog"
+	"sync/atomic"
+)
+
+// redactedKeep is the number of characters of the text form (after the prefix) that are kept when
+// redacting: the 10 characters of the timestamp.
+const redactedKeep = 10
+
+// logRedaction holds whether IDs are redacted when logged.
+var logRedaction atomic.Bool
+
+// SetLogRedaction configures whether IDs are logged in their redacted form, see Redacted. It is safe
+// for concurrent use but is meant to be called once during program initialization.
+func SetLogRedaction(v bool) {
+	logRedaction.Store(v)
+}
+
+// GetLogRedaction returns whether IDs are logged in their redacted form.
+func GetLogRedaction() bool {
+	return logRedaction.Load()
+}
+
+// Redacted returns the prefixed text form with the entropy masked, e.g: "tst_01JBRQS1J5…XZ". The kind
+// and the timestamp remain readable for debugging but the full ID doesn't leak.
+func (id ID[T]) Redacted() string {
+	var kind T
+
+	return redact(id.String(), len(kind.KindShortIdent())+1)
+}
+
+// LogValue implements the slog.LogValuer interface. IDs are logged in the prefixed text form, or in
+// the redacted form when configured through SetLogRedaction.
+func (id ID[T]) LogValue() slog.Value {
+	if GetLogRedaction() {
+		return slog.StringValue(id.Redacted())
+	}
+
+	return slog.StringValue(id.String())
+}
+
+// Redacted returns the prefixed text form with the entropy masked, see ID.Redacted. If the id has no
+// kind the long form is redacted.
+func (id AnyID) Redacted() string {
+	if id.kind == nil {
+		return redact(id.String(), 0)
+	}
+
+	return redact(id.String(), len(id.kind.KindShortIdent())+1)
+}
+
+// LogValue implements the slog.LogValuer interface, see ID.LogValue.
+func (id AnyID) LogValue() slog.Value {
+	if GetLogRedaction() {
+		return slog.StringValue(id.Redacted())
+	}
+
+	return slog.StringValue(id.String())
+}
+
+// redact keeps the prefix of size n plus the timestamp, and the last two characters that hold the
+// kind bits.
+func redact(s string, n int) string {
+	return s[:n+redactedKeep] + "…" + s[len(s)-2:]
+}
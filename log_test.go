@@ -0,0 +1,49 @@
+package sdulid_test
+
+import (
+	"bytes"
+	"log/slog"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("log", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should redact", func() {
+		Expect(id1.Redacted()).To(Equal("tst_01JBRQS1J5…XZ"))
+
+		aid, _, err := sdulid.MustNewRegistry(testID{}).ParseAny(id1.String())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(aid.Redacted()).To(Equal("tst_01JBRQS1J5…XZ"))
+		Expect(sdulid.AnyID{}.Redacted()).To(Equal("0000000000…00"))
+	})
+
+	It("should log in the configured form", func() {
+		DeferCleanup(sdulid.SetLogRedaction, sdulid.GetLogRedaction())
+
+		var buf bytes.Buffer
+		logs := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+			ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+				if a.Key == slog.TimeKey {
+					return slog.Attr{}
+				}
+
+				return a
+			},
+		}))
+
+		logs.Info("a", "id", id1)
+		sdulid.SetLogRedaction(true)
+		logs.Info("b", "id", id1)
+
+		Expect(buf.String()).To(Equal("level=INFO msg=a id=tst_01JBRQS1J5A085FYY2M7ZXXZ\n" +
+			"level=INFO msg=b id=tst_01JBRQS1J5…XZ\n"))
+	})
+})
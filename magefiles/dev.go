@@ -2,8 +2,12 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/fs"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -18,16 +22,27 @@ func init() {
 // Dev namespace holds development commands.
 type Dev mg.Namespace
 
-// Lint our codebase.
+// Lint our codebase, including the nested modules.
 func (Dev) Lint() error {
-	if err := sh.Run("golangci-lint", "run"); err != nil {
-		return fmt.Errorf("failed to run golang-ci: %w", err)
+	dirs, err := nestedModules()
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range append([]string{"."}, dirs...) {
+		cmd := exec.Command("golangci-lint", "run")
+		cmd.Dir, cmd.Stdout, cmd.Stderr = dir, os.Stdout, os.Stderr
+
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to run golang-ci in %s: %w", dir, err)
+		}
 	}
 
 	return nil
 }
 
-// Test the whole codebase.
+// Test the whole codebase. Ginkgo runs each suite from its own directory, so this includes the suites of
+// the nested modules.
 func (Dev) Test() error {
 	return (Dev{}).TestSome("!e2e")
 }
@@ -58,18 +73,89 @@ func (Dev) testSome(labelFilter, dir string) error {
 // error when wrong version format is used.
 var errVersionFormat = fmt.Errorf("version must be in format vX,Y,Z")
 
-// Release tags a new version and pushes it.
+// Release tags a new version and pushes it. The integration packages are nested modules that require
+// the root module through a replace directive, so their requirements are first set to the new version
+// and each nested module is tagged as well, e.g. "sdulidarrow/v1.2.3".
 func (Dev) Release(version string) error {
 	if !regexp.MustCompile(`^v([0-9]+).([0-9]+).([0-9]+)$`).Match([]byte(version)) {
 		return errVersionFormat
 	}
 
-	if err := sh.Run("git", "tag", version); err != nil {
-		return fmt.Errorf("failed to tag version: %w", err)
+	dirs, err := nestedModules()
+	if err != nil {
+		return err
+	}
+
+	tags := []string{version}
+	for _, dir := range dirs {
+		if err := requireVersion(dir, version); err != nil {
+			return err
+		}
+
+		tags = append(tags, dir+"/"+version)
+	}
+
+	if err := sh.Run("git", "commit", "-am", "release "+version); err != nil {
+		return fmt.Errorf("failed to commit the module requirements: %w", err)
+	}
+
+	for _, tag := range tags {
+		if err := sh.Run("git", "tag", tag); err != nil {
+			return fmt.Errorf("failed to tag version: %w", err)
+		}
+	}
+
+	if err := sh.Run("git", append([]string{"push", "origin", "HEAD"}, tags...)...); err != nil {
+		return fmt.Errorf("failed to push version tags: %w", err)
+	}
+
+	return nil
+}
+
+// nestedModules returns the directories of the modules below the root module.
+func nestedModules() (dirs []string, err error) {
+	if err := filepath.WalkDir(".", func(path string, d fs.DirEntry, err error) error {
+		switch {
+		case err != nil:
+			return err
+		case d.IsDir() && d.Name() == "testdata":
+			return filepath.SkipDir
+		case d.Name() == "go.mod" && path != "go.mod":
+			dirs = append(dirs, filepath.ToSlash(filepath.Dir(path)))
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to find nested modules: %w", err)
 	}
 
-	if err := sh.Run("git", "push", "origin", version); err != nil {
-		return fmt.Errorf("failed to push version tag: %w", err)
+	return dirs, nil
+}
+
+// requireVersion sets the requirements of the module in dir on any module of this repository to
+// version.
+func requireVersion(dir, version string) error {
+	out, err := sh.Output("go", "mod", "edit", "-json", filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return fmt.Errorf("failed to read go.mod of %s: %w", dir, err)
+	}
+
+	var mod struct {
+		Require []struct{ Path string }
+	}
+
+	if err := json.Unmarshal([]byte(out), &mod); err != nil {
+		return fmt.Errorf("failed to decode go.mod of %s: %w", dir, err)
+	}
+
+	for _, req := range mod.Require {
+		if req.Path != "github.com/advdv/sdulid" && !strings.HasPrefix(req.Path, "github.com/advdv/sdulid/") {
+			continue
+		}
+
+		if err := sh.Run("go", "mod", "edit", "-require="+req.Path+"@"+version, filepath.Join(dir, "go.mod")); err != nil {
+			return fmt.Errorf("failed to require %s in %s: %w", req.Path, dir, err)
+		}
 	}
 
 	return nil
@@ -0,0 +1,63 @@
+package sdulid
+
+import "crypto/rand"
+
+// Maker generates IDs of kind T. Services can depend on a Maker instead of calling Make directly so
+// tests can inject a deterministic implementation, such as the one in the sdulidtest package.
+type Maker[T Kind] interface {
+	// New generates a new ID, or returns an error if that isn't possible.
+	New() (ID[T], error)
+	// Make is like New but panics when an ID couldn't be generated.
+	Make() ID[T]
+	// NewBatch generates n new IDs, or returns an error if that isn't possible.
+	NewBatch(n int) ([]ID[T], error)
+	// MakeBatch is like NewBatch but panics when the IDs couldn't be generated.
+	MakeBatch(n int) []ID[T]
+}
+
+var (
+	_ Maker[Kind] = CryptoMaker[Kind]{}
+	_ Maker[Kind] = (*MonotonicMaker[Kind])(nil)
+	_ Maker[Kind] = ShardedMaker[Kind]{}
+)
+
+// CryptoMaker generates IDs of kind T with entropy read from crypto/rand. Unlike Make, the entropy is
+// unpredictable. The zero value is ready to use and it is safe for concurrent use.
+type CryptoMaker[T Kind] struct {
+	// Clock provides the time of new IDs, it defaults to the wall clock when nil.
+	Clock Clock
+	// Hook is optionally called for every generated ID.
+	Hook Hook
+}
+
+// New generates a new ID, it returns an error if reading from crypto/rand fails.
+func (m CryptoMaker[T]) New() (ID[T], error) {
+	id, err := New[T](WithEntropy(rand.Reader), WithClock(m.Clock))
+	if err == nil {
+		m.onMake(1)
+	}
+
+	return id, err
+}
+
+// onMake calls the hook, if any, for each of n generated IDs.
+func (m CryptoMaker[T]) onMake(n int) {
+	if m.Hook == nil {
+		return
+	}
+
+	var kind T
+	for range n {
+		m.Hook.OnMake(kind.KindNumber())
+	}
+}
+
+// Make is like New but panics when an ID couldn't be generated.
+func (m CryptoMaker[T]) Make() ID[T] {
+	id, err := m.New()
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
@@ -0,0 +1,72 @@
+package sdulid_test
+
+import (
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// idService shows how a service depends on the Maker interface rather than on Make.
+type idService struct {
+	ids sdulid.Maker[testID]
+}
+
+func (s idService) create() sdulid.ID[testID] { return s.ids.Make() }
+
+func must[V any](v V, err error) V {
+	Expect(err).ToNot(HaveOccurred())
+
+	return v
+}
+
+var _ = Describe("maker", func() {
+	DescribeTable("implementations",
+		func(m sdulid.Maker[testID]) {
+			id1, err := m.New()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(id1.IsZero()).To(BeFalse())
+
+			id2 := idService{ids: m}.create()
+			Expect(id2).ToNot(Equal(id1))
+			Expect(id2.Bytes()[14:]).To(Equal([]byte{255, 255}))
+
+			ids, err := m.NewBatch(3)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ids).To(HaveLen(3))
+			Expect(ids).ToNot(ContainElements(id1, id2))
+			Expect(m.MakeBatch(2)).To(HaveLen(2))
+		},
+		Entry("crypto", sdulid.CryptoMaker[testID]{}),
+		Entry("monotonic", sdulid.NewMonotonicMaker[testID](nil, 0)),
+		Entry("sharded", must(sdulid.NewShardedMaker[testID](4, 9))),
+	)
+
+	It("should embed the shard in the entropy", func() {
+		for bits := uint(1); bits <= sdulid.MaxShardBits; bits++ {
+			shard := uint16(1<<bits - 1)
+			mkr, err := sdulid.NewShardedMaker[testID](bits, shard)
+			Expect(err).ToNot(HaveOccurred())
+
+			for range 10 {
+				id := mkr.Make()
+				Expect(sdulid.ShardOf(id, bits)).To(Equal(shard))
+				Expect(sdulid.MustParse[testID](id.String())).To(Equal(id))
+			}
+
+			for _, id := range mkr.MakeBatch(10) {
+				Expect(sdulid.ShardOf(id, bits)).To(Equal(shard))
+				Expect(id.Bytes()[14:]).To(Equal([]byte{255, 255}))
+			}
+		}
+	})
+
+	DescribeTable("invalid shards",
+		func(bits uint, shard uint16) {
+			_, err := sdulid.NewShardedMaker[testID](bits, shard)
+			Expect(err).To(MatchError(sdulid.ErrInvalidShard))
+		},
+		Entry("no bits", uint(0), uint16(0)),
+		Entry("too many bits", uint(17), uint16(0)),
+		Entry("shard too large", uint(4), uint16(16)),
+	)
+})
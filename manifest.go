@@ -0,0 +1,245 @@
+package sdulid
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// KindManifest is the canonical description of all kinds in a registry. It is exported as JSON so
+// changes to the kinds can be reviewed and checked for breaking changes, see DiffManifests.
+type KindManifest struct {
+	Kinds        []ManifestKind `json:"kinds"`
+	Reservations []Reservation  `json:"reservations,omitempty"`
+}
+
+// ManifestKind describes a single kind in a KindManifest.
+type ManifestKind struct {
+	Number     uint16   `json:"number"`
+	Ident      string   `json:"ident"`
+	ShortIdent string   `json:"short_ident"`
+	Aliases    []string `json:"aliases,omitempty"`
+	Owner      string   `json:"owner,omitempty"`
+	Retired    bool     `json:"retired,omitempty"`
+}
+
+// KindNumber implements Kind.
+func (k ManifestKind) KindNumber() uint16 { return k.Number }
+
+// KindIdent implements Kind.
+func (k ManifestKind) KindIdent() string { return k.Ident }
+
+// KindShortIdent implements Kind.
+func (k ManifestKind) KindShortIdent() string { return k.ShortIdent }
+
+// KindAliases implements AliasedKind.
+func (k ManifestKind) KindAliases() []string { return k.Aliases }
+
+// KindOwner implements OwnedKind.
+func (k ManifestKind) KindOwner() string { return k.Owner }
+
+// importedKind is the comparable form of a ManifestKind that ImportManifest registers, so AnyIDs of
+// imported kinds can be compared with == and used as map keys. The aliases are joined by commas, which
+// short idents can't hold.
+type importedKind struct {
+	number     uint16
+	ident      string
+	shortIdent string
+	aliases    string
+	owner      string
+}
+
+func (k importedKind) KindNumber() uint16     { return k.number }
+func (k importedKind) KindIdent() string      { return k.ident }
+func (k importedKind) KindShortIdent() string { return k.shortIdent }
+func (k importedKind) KindOwner() string      { return k.owner }
+
+func (k importedKind) KindAliases() []string {
+	if k.aliases == "" {
+		return nil
+	}
+
+	return strings.Split(k.aliases, ",")
+}
+
+// Manifest returns the manifest of the registered kinds, ordered by kind number.
+func (r *Registry) Manifest() *KindManifest {
+	kinds := r.Kinds()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	manifest := &KindManifest{Kinds: make([]ManifestKind, 0, len(kinds)), Reservations: slices.Clone(r.reserved)}
+	for _, kind := range kinds {
+		manifest.Kinds = append(manifest.Kinds, ManifestKind{
+			Number:     kind.KindNumber(),
+			Ident:      kind.KindIdent(),
+			ShortIdent: kind.KindShortIdent(),
+			Aliases:    shortIdents(kind)[1:],
+			Owner:      kindOwner(kind),
+			Retired:    r.retired[kind.KindNumber()],
+		})
+	}
+
+	return manifest
+}
+
+// ExportManifest returns the manifest of the kinds in reg as canonical JSON: kinds are ordered by
+// number and the output is indented, so it can be committed and diffed.
+func ExportManifest(reg *Registry) ([]byte, error) {
+	data, err := json.MarshalIndent(reg.Manifest(), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	return append(data, '\n'), nil
+}
+
+// ImportManifest decodes a manifest that was exported with ExportManifest into a registry, including
+// the reservations and retired kinds. The kinds of the registry are comparable, unlike ManifestKind, so
+// the AnyIDs it parses can be used as map keys.
+func ImportManifest(data []byte) (*Registry, error) {
+	var manifest KindManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+
+	reg, err := NewRegistry()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := reg.Reserve(manifest.Reservations...); err != nil {
+		return nil, err
+	}
+
+	for _, mkind := range manifest.Kinds {
+		kind := importedKind{
+			number:     mkind.Number,
+			ident:      mkind.Ident,
+			shortIdent: mkind.ShortIdent,
+			aliases:    strings.Join(mkind.Aliases, ","),
+			owner:      mkind.Owner,
+		}
+
+		if mkind.Retired {
+			err = reg.Retire(kind)
+		} else {
+			err = reg.Register(kind)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return reg, nil
+}
+
+// ManifestChange is a change between two manifests, as reported by DiffManifests.
+type ManifestChange struct {
+	// Breaking is true when IDs that were generated with the old kinds no longer parse, or parse as
+	// a different kind.
+	Breaking bool
+	// Message describes the change.
+	Message string
+}
+
+// String returns the message prefixed with whether the change is breaking.
+func (c ManifestChange) String() string {
+	if c.Breaking {
+		return "breaking: " + c.Message
+	}
+
+	return "ok: " + c.Message
+}
+
+// DiffManifests compares the kinds of two manifests. Changing the number, ident or short ident of a
+// kind and removing a kind are breaking changes, unless the old short ident is kept as an alias or the
+// kind is retired instead. Changes are ordered by kind number.
+func DiffManifests(from, to *KindManifest) []ManifestChange {
+	fromByNumber, toByNumber := map[uint16]ManifestKind{}, map[uint16]ManifestKind{}
+	for _, kind := range from.Kinds {
+		fromByNumber[kind.Number] = kind
+	}
+
+	for _, kind := range to.Kinds {
+		toByNumber[kind.Number] = kind
+	}
+
+	numbers := make([]uint16, 0, len(fromByNumber)+len(toByNumber))
+	for number := range fromByNumber {
+		numbers = append(numbers, number)
+	}
+
+	for number := range toByNumber {
+		if _, ok := fromByNumber[number]; !ok {
+			numbers = append(numbers, number)
+		}
+	}
+
+	slices.SortFunc(numbers, cmp.Compare)
+
+	var changes []ManifestChange
+	for _, number := range numbers {
+		changes = append(changes, diffKind(fromByNumber, toByNumber, number)...)
+	}
+
+	return changes
+}
+
+// diffKind returns the changes of the kind with number between the old and new kinds.
+func diffKind(from, to map[uint16]ManifestKind, number uint16) []ManifestChange {
+	prev, hadPrev := from[number]
+	next, hasNext := to[number]
+
+	switch {
+	case !hadPrev:
+		for _, kind := range from {
+			if kind.Ident == next.Ident {
+				return []ManifestChange{{Breaking: true, Message: fmt.Sprintf(
+					"kind %q moved from number %d to %d", next.Ident, kind.Number, number)}}
+			}
+		}
+
+		return []ManifestChange{{Message: fmt.Sprintf("kind %q added with number %d", next.Ident, number)}}
+	case !hasNext:
+		return []ManifestChange{{Breaking: true, Message: fmt.Sprintf(
+			"kind %q with number %d removed, retire it instead", prev.Ident, number)}}
+	}
+
+	var changes []ManifestChange
+	if prev.Ident != next.Ident {
+		changes = append(changes, ManifestChange{Breaking: true, Message: fmt.Sprintf(
+			"kind number %d of %q reused by %q", number, prev.Ident, next.Ident)})
+	}
+
+	if prev.ShortIdent != next.ShortIdent {
+		if slices.Contains(next.Aliases, prev.ShortIdent) {
+			changes = append(changes, ManifestChange{Message: fmt.Sprintf(
+				"prefix of %q changed from %q to %q, the old prefix is kept as an alias",
+				next.Ident, prev.ShortIdent, next.ShortIdent)})
+		} else {
+			changes = append(changes, ManifestChange{Breaking: true, Message: fmt.Sprintf(
+				"prefix of %q changed from %q to %q, keep the old prefix as an alias",
+				next.Ident, prev.ShortIdent, next.ShortIdent)})
+		}
+	}
+
+	for _, alias := range prev.Aliases {
+		if alias != next.ShortIdent && !slices.Contains(next.Aliases, alias) {
+			changes = append(changes, ManifestChange{Breaking: true, Message: fmt.Sprintf(
+				"alias %q of %q removed", alias, next.Ident)})
+		}
+	}
+
+	if !prev.Retired && next.Retired {
+		changes = append(changes, ManifestChange{Message: fmt.Sprintf("kind %q retired", next.Ident)})
+	} else if prev.Retired && !next.Retired {
+		changes = append(changes, ManifestChange{Message: fmt.Sprintf("kind %q no longer retired", next.Ident)})
+	}
+
+	return changes
+}
@@ -0,0 +1,110 @@
+package sdulid_test
+
+import (
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("manifest", func() {
+	It("should export and import the kinds", func() {
+		reg := sdulid.MustNewRegistry(testID{}, renamedID{})
+		Expect(reg.Reserve(sdulid.Reservation{Owner: "billing", From: 100, To: 199})).To(Succeed())
+		Expect(reg.Retire(otherID{})).To(Succeed())
+
+		data, err := sdulid.ExportManifest(reg)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(`{
+  "kinds": [
+    {
+      "number": 1,
+      "ident": "other",
+      "short_ident": "oth",
+      "retired": true
+    },
+    {
+      "number": 12,
+      "ident": "renamed",
+      "short_ident": "rnm",
+      "aliases": [
+        "old"
+      ]
+    },
+    {
+      "number": 65535,
+      "ident": "test",
+      "short_ident": "tst"
+    }
+  ],
+  "reservations": [
+    {
+      "owner": "billing",
+      "from": 100,
+      "to": 199
+    }
+  ]
+}
+`))
+
+		imported, err := sdulid.ImportManifest(data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(imported.Manifest()).To(Equal(reg.Manifest()))
+		Expect(imported.IsRetired(1)).To(BeTrue())
+
+		id1, kind, err := imported.ParseAny("old_01JBRQS1J5A085FYY2M7ZX00")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kind.KindIdent()).To(Equal("renamed"))
+
+		id2, _, err := imported.ParseAny(id1.String())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(id2 == id1).To(BeTrue())
+
+		seen := map[sdulid.AnyID]int{}
+		for _, id := range []sdulid.AnyID{id1, id2} {
+			seen[id]++
+		}
+
+		Expect(seen).To(Equal(map[sdulid.AnyID]int{id1: 2}))
+
+		_, err = sdulid.ImportManifest([]byte(`{`))
+		Expect(err).To(MatchError(ContainSubstring("failed to decode manifest")))
+	})
+
+	It("should diff manifests", func() {
+		from := &sdulid.KindManifest{Kinds: []sdulid.ManifestKind{
+			{Number: 1, Ident: "account", ShortIdent: "acc"},
+			{Number: 2, Ident: "user", ShortIdent: "usr"},
+			{Number: 3, Ident: "order", ShortIdent: "ord", Aliases: []string{"odr"}},
+			{Number: 4, Ident: "invoice", ShortIdent: "inv"},
+			{Number: 5, Ident: "payment", ShortIdent: "pay"},
+			{Number: 6, Ident: "refund", ShortIdent: "ref"},
+		}}
+		to := &sdulid.KindManifest{Kinds: []sdulid.ManifestKind{
+			{Number: 1, Ident: "account", ShortIdent: "act"},
+			{Number: 2, Ident: "member", ShortIdent: "mbr", Aliases: []string{"usr"}},
+			{Number: 3, Ident: "order", ShortIdent: "ord"},
+			{Number: 5, Ident: "payment", ShortIdent: "pay", Retired: true},
+			{Number: 7, Ident: "refund", ShortIdent: "ref"},
+			{Number: 8, Ident: "product", ShortIdent: "prd"},
+		}}
+
+		changes := make([]string, 0)
+		for _, change := range sdulid.DiffManifests(from, to) {
+			changes = append(changes, change.String())
+		}
+
+		Expect(changes).To(Equal([]string{
+			`breaking: prefix of "account" changed from "acc" to "act", keep the old prefix as an alias`,
+			`breaking: kind number 2 of "user" reused by "member"`,
+			`ok: prefix of "member" changed from "usr" to "mbr", the old prefix is kept as an alias`,
+			`breaking: alias "odr" of "order" removed`,
+			`breaking: kind "invoice" with number 4 removed, retire it instead`,
+			`ok: kind "payment" retired`,
+			`breaking: kind "refund" with number 6 removed, retire it instead`,
+			`breaking: kind "refund" moved from number 6 to 7`,
+			`ok: kind "product" added with number 8`,
+		}))
+
+		Expect(sdulid.DiffManifests(from, from)).To(BeEmpty())
+	})
+})
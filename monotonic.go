@@ -0,0 +1,327 @@
+package sdulid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// MonotonicMaker generates IDs of kind T that are strictly increasing, also when multiple IDs are
+// generated within the same millisecond. This keeps index locality intact for insert-heavy tables.
+// It is safe for concurrent use.
+//
+// Within the same millisecond, the entropy of the previous ID is incremented. Only the first 8 bytes of
+// the entropy are incremented since the last two bytes of every ID are reserved for the kind suffix.
+// When the clock goes backwards, the timestamp of the previous ID is reused so ordering is kept.
+//
+// ulid.MonotonicEntropy can't be wrapped for this: it increments all 10 bytes of the entropy, so the
+// increments land in the bytes that the suffix overwrites and consecutive IDs could be equal. The
+// overflow range is therefore 64 bits per millisecond rather than 80.
+type MonotonicMaker[T Kind] struct {
+	mu      sync.Mutex
+	entropy io.Reader
+	inc     uint64
+	opts    monotonicOptions
+	ms      uint64
+	last    uint64
+	skewed  bool
+}
+
+// NewMonotonicMaker inits a maker that reads entropy from r. Within the same millisecond the entropy
+// is incremented by a random number between 1 and inc (inclusive). When inc is zero it defaults to
+// math.MaxUint32, when r is nil it defaults to crypto/rand.Reader.
+func NewMonotonicMaker[T Kind](r io.Reader, inc uint64, opts ...MonotonicOption) *MonotonicMaker[T] {
+	if r == nil {
+		r = rand.Reader
+	}
+
+	if inc == 0 {
+		inc = math.MaxUint32
+	}
+
+	m := &MonotonicMaker[T]{entropy: r, inc: inc}
+	for _, opt := range opts {
+		opt(&m.opts)
+	}
+
+	return m
+}
+
+// New generates a new ID that is strictly larger than any ID previously generated by this maker.
+// ErrMonotonicOverflow is returned when the entropy space for the current millisecond is exhausted.
+// With a skew guard, ErrClockSkew is returned when the clock went backwards too far.
+func (m *MonotonicMaker[T]) New() (id ID[T], err error) {
+	ms := now(m.opts.clock)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.next(ms)
+}
+
+// NewBatch generates n IDs that are strictly increasing, and strictly larger than any ID previously
+// generated by this maker. The clock is read once, so the IDs share the timestamp unless the entropy
+// of that millisecond runs out, in which case ErrMonotonicOverflow is returned and no IDs are. The state
+// of the maker is only advanced when the whole batch succeeds. ErrInvalidBatchSize is returned when n
+// is negative.
+func (m *MonotonicMaker[T]) NewBatch(n int) ([]ID[T], error) {
+	if n < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidBatchSize, n)
+	}
+
+	ms := now(m.opts.clock)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	prevMS, prevLast := m.ms, m.last
+
+	ids := make([]ID[T], n)
+	for i := range ids {
+		var err error
+		if ids[i], err = m.next(ms); err != nil {
+			m.ms, m.last = prevMS, prevLast
+
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
+// MakeBatch is like NewBatch but panics when the IDs couldn't be generated.
+func (m *MonotonicMaker[T]) MakeBatch(n int) []ID[T] {
+	ids, err := m.NewBatch(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return ids
+}
+
+// next generates the next ID for the time ms, m.mu must be held.
+func (m *MonotonicMaker[T]) next(ms uint64) (id ID[T], err error) {
+	if m.opts.skewGuard {
+		if err := m.guardSkew(ms); err != nil {
+			return id, err
+		}
+	}
+
+	if m.ms != 0 && ms <= m.ms {
+		ms = m.ms
+
+		n, err := m.increment()
+		if err != nil {
+			return id, err
+		}
+
+		if m.last+n < m.last {
+			if m.opts.hook != nil {
+				var kind T
+				m.opts.hook.OnOverflow(kind.KindNumber())
+			}
+
+			return id, ErrMonotonicOverflow
+		}
+
+		m.last += n
+	} else {
+		// the upper two bytes of the ulid entropy would be overwritten by the suffix.
+		var buf [10]byte
+		if _, err := io.ReadFull(m.entropy, buf[:]); err != nil {
+			return id, fmt.Errorf("failed to read monotonic entropy: %w", err)
+		}
+
+		m.last = binary.BigEndian.Uint64(buf[2:])
+	}
+
+	if err := id.ULID.SetTime(ms); err != nil {
+		return id, fmt.Errorf("failed to set time: %w", err)
+	}
+
+	m.ms = ms
+	binary.BigEndian.PutUint64(id.ULID[6:14], m.last)
+	id.putSuffixBytes()
+
+	if m.opts.hook != nil {
+		var kind T
+		m.opts.hook.OnMake(kind.KindNumber())
+	}
+
+	return id, nil
+}
+
+// guardSkew returns ErrClockSkew when the clock is behind the previous ID by more than the threshold, or
+// notifies onSkew once when it falls behind and once more when it caught up again. m.mu must be held.
+func (m *MonotonicMaker[T]) guardSkew(ms uint64) error {
+	if ms >= m.ms {
+		if m.skewed {
+			m.skewed = false
+			m.opts.onSkew(0)
+		}
+
+		return nil
+	}
+
+	skew := time.Duration(m.ms-ms) * time.Millisecond
+	if skew <= m.opts.skewThreshold {
+		return nil
+	}
+
+	if m.opts.onSkew == nil {
+		return fmt.Errorf("%w: by %s", ErrClockSkew, skew)
+	}
+
+	if !m.skewed {
+		m.skewed = true
+		m.opts.onSkew(skew)
+	}
+
+	return nil
+}
+
+// increment returns a random increment between 1 and m.inc (inclusive).
+func (m *MonotonicMaker[T]) increment() (uint64, error) {
+	if m.inc == 1 {
+		return 1, nil
+	}
+
+	var buf [8]byte
+	if _, err := io.ReadFull(m.entropy, buf[:]); err != nil {
+		return 0, fmt.Errorf("failed to read monotonic entropy: %w", err)
+	}
+
+	return 1 + binary.BigEndian.Uint64(buf[:])%m.inc, nil
+}
+
+// Make is like New but panics when an ID couldn't be generated.
+func (m *MonotonicMaker[T]) Make() ID[T] {
+	id, err := m.New()
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
+
+// MonotonicState is the state of a MonotonicMaker: the timestamp and entropy of the last ID.
+type MonotonicState struct {
+	// MS is the timestamp of the last ID in Unix milliseconds.
+	MS uint64
+	// Entropy holds the lower 8 bytes of the entropy of the last ID.
+	Entropy uint64
+}
+
+// Snapshot returns the current state of the maker so it can be restored after a restart.
+func (m *MonotonicMaker[T]) Snapshot() MonotonicState {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return MonotonicState{MS: m.ms, Entropy: m.last}
+}
+
+// Restore continues from a snapshot of a (previous) maker, so new IDs are larger than the IDs that
+// were generated before the snapshot was taken. This prevents ordering regressions when a service
+// restarts within the same millisecond, or while its clock is behind. A snapshot that is older than
+// the current state is ignored.
+func (m *MonotonicMaker[T]) Restore(s MonotonicState) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if s.MS > m.ms || (s.MS == m.ms && s.Entropy > m.last) {
+		m.ms, m.last = s.MS, s.Entropy
+	}
+}
+
+// MonotonicStore persists the state of a MonotonicMaker.
+type MonotonicStore interface {
+	// LoadMonotonicState returns the stored state, or the zero state if nothing was stored yet.
+	LoadMonotonicState() (MonotonicState, error)
+	// SaveMonotonicState stores the state.
+	SaveMonotonicState(s MonotonicState) error
+}
+
+// Save stores a snapshot of the maker in store, e.g. during a graceful shutdown.
+func (m *MonotonicMaker[T]) Save(store MonotonicStore) error {
+	return store.SaveMonotonicState(m.Snapshot()) //nolint:wrapcheck
+}
+
+// Load restores the maker from the state in store, see Restore.
+func (m *MonotonicMaker[T]) Load(store MonotonicStore) error {
+	s, err := store.LoadMonotonicState()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	m.Restore(s)
+
+	return nil
+}
+
+// monotonicStateSize is the size of the file that a MonotonicFile stores the state in.
+const monotonicStateSize = 16
+
+// MonotonicFile is a MonotonicStore that stores the state in the file at the path.
+type MonotonicFile string
+
+// LoadMonotonicState reads the state from the file, a missing file results in the zero state.
+func (f MonotonicFile) LoadMonotonicState() (s MonotonicState, err error) {
+	data, err := os.ReadFile(string(f))
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	} else if err != nil {
+		return s, fmt.Errorf("failed to read monotonic state: %w", err)
+	}
+
+	if len(data) != monotonicStateSize {
+		return s, fmt.Errorf("failed to read monotonic state: %w", ulid.ErrDataSize)
+	}
+
+	return MonotonicState{MS: binary.BigEndian.Uint64(data), Entropy: binary.BigEndian.Uint64(data[8:])}, nil
+}
+
+// SaveMonotonicState writes the state to the file, replacing it atomically. The state is written to a
+// temporary file in the same directory that is synced to disk before it is renamed over the file, so a
+// crash never leaves a truncated or stale state behind.
+func (f MonotonicFile) SaveMonotonicState(s MonotonicState) (err error) {
+	data := binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(nil, s.MS), s.Entropy)
+
+	tmp, err := os.CreateTemp(filepath.Dir(string(f)), filepath.Base(string(f))+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create monotonic state: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		return fmt.Errorf("failed to write monotonic state: %w", err)
+	}
+
+	if err := tmp.Sync(); err != nil {
+		return fmt.Errorf("failed to sync monotonic state: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close monotonic state: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), string(f)); err != nil {
+		return fmt.Errorf("failed to replace monotonic state: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package sdulid_test
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("monotonic maker", func() {
+	It("should generate strictly increasing ids with the suffix", func() {
+		mkr := sdulid.NewMonotonicMaker[testID](nil, 1)
+
+		prev := mkr.Make()
+		for range 10_000 {
+			next := mkr.Make()
+			Expect(next.Bytes()[14:]).To(Equal([]byte{255, 255}))
+			Expect(bytes.Compare(next.Bytes(), prev.Bytes())).To(Equal(1))
+			Expect(next.String() > prev.String()).To(BeTrue())
+			prev = next
+		}
+	})
+
+	It("should generate strictly increasing batches", func() {
+		mkr := sdulid.NewMonotonicMaker[testID](nil, 1)
+
+		prev := mkr.Make()
+		for _, next := range mkr.MakeBatch(1_000) {
+			Expect(next.Bytes()[14:]).To(Equal([]byte{255, 255}))
+			Expect(bytes.Compare(next.Bytes(), prev.Bytes())).To(Equal(1))
+			prev = next
+		}
+
+		Expect(bytes.Compare(mkr.Make().Bytes(), prev.Bytes())).To(Equal(1))
+	})
+
+	It("should be safe for concurrent use", func() {
+		mkr := sdulid.NewMonotonicMaker[testID](nil, 0)
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		var all []string
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				for range 1_000 {
+					id := mkr.Make()
+					mu.Lock()
+					all = append(all, id.String())
+					mu.Unlock()
+				}
+			}()
+		}
+
+		wg.Wait()
+		Expect(slices.Compact(slices.Sorted(slices.Values(all)))).To(HaveLen(8_000))
+	})
+
+	DescribeTable("overflow",
+		func(pattern []byte) {
+			mkr := sdulid.NewMonotonicMaker[testID](bytes.NewReader(bytes.Repeat(pattern, 1024)), 1)
+
+			var err error
+			for range 100 {
+				if _, err = mkr.New(); err != nil {
+					break
+				}
+			}
+
+			Expect(err).To(MatchError(sdulid.ErrMonotonicOverflow))
+
+			_, err = mkr.NewBatch(2)
+			Expect(err).To(MatchError(sdulid.ErrMonotonicOverflow))
+		},
+		Entry("all entropy bytes", []byte{255, 255, 255, 255, 255, 255, 255, 255, 255, 255}),
+		Entry("lower entropy bytes", []byte{0, 0, 255, 255, 255, 255, 255, 255, 255, 255}),
+	)
+
+	It("should only advance the state when the whole batch succeeds", func() {
+		clock := &fakeClock{t: time.UnixMilli(1730628322885)}
+		mkr := sdulid.NewMonotonicMaker[testID](nil, 1, sdulid.WithMonotonicClock(clock))
+		mkr.Restore(sdulid.MonotonicState{MS: 1730628322885, Entropy: math.MaxUint64 - 2})
+
+		_, err := mkr.NewBatch(3)
+		Expect(err).To(MatchError(sdulid.ErrMonotonicOverflow))
+		Expect(mkr.Snapshot()).To(Equal(sdulid.MonotonicState{MS: 1730628322885, Entropy: math.MaxUint64 - 2}))
+
+		Expect(mkr.MakeBatch(2)).To(HaveLen(2))
+		Expect(mkr.Snapshot()).To(Equal(sdulid.MonotonicState{MS: 1730628322885, Entropy: math.MaxUint64}))
+
+		_, err = mkr.NewBatch(-1)
+		Expect(err).To(MatchError(sdulid.ErrInvalidBatchSize))
+		Expect(mkr.MakeBatch(0)).To(BeEmpty())
+	})
+
+	It("should overflow at the boundary of the 8 incremented bytes", func() {
+		clock := &fakeClock{t: time.UnixMilli(1730628322885)}
+		mkr := sdulid.NewMonotonicMaker[testID](nil, 1, sdulid.WithMonotonicClock(clock))
+		mkr.Restore(sdulid.MonotonicState{MS: 1730628322885, Entropy: math.MaxUint64 - 1})
+
+		last := mkr.Make()
+		Expect(last.Bytes()[6:]).To(Equal([]byte{255, 255, 255, 255, 255, 255, 255, 255, 255, 255}))
+
+		_, err := mkr.New()
+		Expect(err).To(MatchError(sdulid.ErrMonotonicOverflow))
+
+		clock.t = clock.t.Add(time.Millisecond)
+		Expect(bytes.Compare(mkr.Make().Bytes(), last.Bytes())).To(Equal(1))
+	})
+
+	It("should continue after a restored snapshot", func() {
+		future := uint64(time.Now().Add(time.Hour).UnixMilli())
+
+		mkr := sdulid.NewMonotonicMaker[testID](nil, 0)
+		mkr.Restore(sdulid.MonotonicState{MS: future, Entropy: 42})
+
+		id1 := mkr.Make()
+		Expect(id1.Timestamp()).To(Equal(future))
+		Expect(bytes.Compare(id1.Bytes()[6:14], []byte{0, 0, 0, 0, 0, 0, 0, 42})).To(Equal(1))
+
+		snap := mkr.Snapshot()
+		Expect(snap.MS).To(Equal(future))
+
+		mkr.Restore(sdulid.MonotonicState{MS: future - 1, Entropy: 1})
+		Expect(mkr.Snapshot()).To(Equal(snap))
+	})
+
+	It("should persist the state to a file", func() {
+		store := sdulid.MonotonicFile(filepath.Join(GinkgoT().TempDir(), "state"))
+
+		mkr1 := sdulid.NewMonotonicMaker[testID](nil, 0)
+		Expect(mkr1.Load(store)).To(Succeed())
+		Expect(mkr1.Snapshot()).To(Equal(sdulid.MonotonicState{}))
+
+		last := mkr1.Make()
+		Expect(mkr1.Save(store)).To(Succeed())
+
+		mkr2 := sdulid.NewMonotonicMaker[testID](nil, 0)
+		Expect(mkr2.Load(store)).To(Succeed())
+		Expect(mkr2.Snapshot()).To(Equal(mkr1.Snapshot()))
+		Expect(bytes.Compare(mkr2.Make().Bytes(), last.Bytes())).To(Equal(1))
+
+		entries, err := os.ReadDir(filepath.Dir(string(store)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+
+		Expect(os.WriteFile(string(store), []byte("foo"), 0o600)).To(Succeed())
+		Expect(mkr2.Load(store)).To(MatchError(ContainSubstring("failed to read monotonic state")))
+
+		missing := sdulid.MonotonicFile(filepath.Join(GinkgoT().TempDir(), "missing", "state"))
+		Expect(mkr2.Save(missing)).To(MatchError(ContainSubstring("failed to create monotonic state")))
+	})
+})
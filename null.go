@@ -0,0 +1,81 @@
+package sdulid
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// NullID represents an ID of kind T that may be null, analogous to sql.NullString. It implements
+// the sql.Scanner and driver.Valuer interfaces for nullable columns, and encodes as JSON null when
+// not valid.
+type NullID[T Kind] struct {
+	ID    ID[T]
+	Valid bool // Valid is true if ID is not NULL
+}
+
+// NewNullID returns a valid NullID holding id.
+func NewNullID[T Kind](id ID[T]) NullID[T] {
+	return NullID[T]{ID: id, Valid: true}
+}
+
+// Scan implements the sql.Scanner interface. A nil src results in an invalid NullID, other values are
+// scanned like ID.Scan.
+func (n *NullID[T]) Scan(src any) error {
+	if src == nil {
+		n.ID, n.Valid = ID[T]{}, false
+
+		return nil
+	}
+
+	if err := n.ID.Scan(src); err != nil {
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface, returning nil when the NullID is not valid.
+func (n NullID[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.ID.Value()
+}
+
+// MarshalJSON implements the json.Marshaler interface, encoding JSON null when the NullID is not valid.
+func (n NullID[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return n.ID.MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. JSON null results in an invalid NullID.
+func (n *NullID[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		n.ID, n.Valid = ID[T]{}, false
+
+		return nil
+	}
+
+	if err := n.ID.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
+
+// Ptr returns a pointer to the ID if the NullID is valid, or nil otherwise.
+func (n NullID[T]) Ptr() *ID[T] {
+	if !n.Valid {
+		return nil
+	}
+
+	return &n.ID
+}
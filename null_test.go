@@ -0,0 +1,78 @@
+package sdulid_test
+
+import (
+	"encoding/json"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("null id", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	Describe("sql", func() {
+		It("should value null and valid", func() {
+			v, err := sdulid.NullID[testID]{}.Value()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(v).To(BeNil())
+
+			v, err = sdulid.NewNullID(id1).Value()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(v).To(Equal(id1.Bytes()))
+		})
+
+		It("should scan null and valid", func() {
+			n := sdulid.NewNullID(id1)
+			Expect(n.Scan(nil)).To(Succeed())
+			Expect(n.Valid).To(BeFalse())
+			Expect(n.ID.IsZero()).To(BeTrue())
+
+			Expect(n.Scan(id1.Bytes())).To(Succeed())
+			Expect(n).To(Equal(sdulid.NewNullID(id1)))
+		})
+
+		It("should not be valid on scan error", func() {
+			var n sdulid.NullID[otherID]
+			Expect(n.Scan(id1.Bytes())).To(MatchError(sdulid.ErrInvalidSuffix))
+			Expect(n.Valid).To(BeFalse())
+		})
+	})
+
+	Describe("json", func() {
+		type patch struct {
+			Owner sdulid.NullID[testID] `json:"owner"`
+		}
+
+		It("should marshal null and valid", func() {
+			data, err := json.Marshal(patch{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`{"owner":null}`))
+
+			data, err = json.Marshal(patch{Owner: sdulid.NewNullID(id1)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`{"owner":"tst_01JBRQS1J5A085FYY2M7ZXXZ"}`))
+		})
+
+		It("should unmarshal null and valid", func() {
+			p := patch{Owner: sdulid.NewNullID(id1)}
+			Expect(json.Unmarshal([]byte(`{"owner":null}`), &p)).To(Succeed())
+			Expect(p.Owner.Valid).To(BeFalse())
+			Expect(p.Owner.Ptr()).To(BeNil())
+
+			Expect(json.Unmarshal([]byte(`{"owner":"tst_01JBRQS1J5A085FYY2M7ZXXZ"}`), &p)).To(Succeed())
+			Expect(p.Owner.Valid).To(BeTrue())
+			Expect(*p.Owner.Ptr()).To(Equal(id1))
+		})
+
+		It("should error on invalid", func() {
+			var p patch
+			Expect(json.Unmarshal([]byte(`{"owner":"oth_01JBRQS1J5A085FYY2M7ZXW0"}`), &p)).To(MatchError(sdulid.ErrWrongKind))
+			Expect(p.Owner.Valid).To(BeFalse())
+		})
+	})
+})
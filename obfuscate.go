@@ -0,0 +1,68 @@
+package sdulid
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// ErrInvalidToken is returned when an obfuscated token can't be decoded into an ID of the expected kind.
+var ErrInvalidToken = errors.New("sdulid: invalid token")
+
+// Obfuscator turns IDs into opaque public tokens and back, so the creation time that is embedded in
+// every ID isn't leaked to end users. Since IDs are exactly one AES block, the token is the encrypted
+// block in the base32 alphabet, prefixed like the text form: e.g. "tst_3TFJ7ZJSQ0B5VCT8P5G2WM0RNE".
+// Tokens are deterministic: the same ID and key always yield the same token. It is safe for concurrent use.
+type Obfuscator struct {
+	block cipher.Block
+}
+
+// NewObfuscator inits an obfuscator with an AES key of 16, 24 or 32 bytes.
+func NewObfuscator(key []byte) (*Obfuscator, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init cipher: %w", err)
+	}
+
+	return &Obfuscator{block: block}, nil
+}
+
+// Obfuscate returns the opaque token for id.
+func Obfuscate[T Kind](o *Obfuscator, id ID[T]) string {
+	var kind T
+
+	var token ulid.ULID
+	o.block.Encrypt(token[:], id.ULID[:])
+
+	return kind.KindShortIdent() + "_" + token.String()
+}
+
+// Deobfuscate decodes a token that was created by Obfuscate back into the ID. It returns ErrInvalidToken
+// if the token is malformed, was created with another key or describes another kind.
+func Deobfuscate[T Kind](o *Obfuscator, token string) (id ID[T], err error) {
+	var kind T
+
+	encoded, found := strings.CutPrefix(token, kind.KindShortIdent()+"_")
+	if !found {
+		return id, ErrInvalidToken
+	}
+
+	block, err := ulid.ParseStrict(encoded)
+	if err != nil {
+		return id, ErrInvalidToken
+	}
+
+	o.block.Decrypt(id.ULID[:], block[:])
+
+	// with another key (or a forged token) the suffix is effectively random.
+	if binary.BigEndian.Uint16(id.ULID[14:]) != kind.KindNumber() {
+		return ID[T]{}, ErrInvalidToken
+	}
+
+	return id, nil
+}
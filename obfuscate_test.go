@@ -0,0 +1,61 @@
+package sdulid_test
+
+import (
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("obfuscate", func() {
+	var id1 sdulid.ID[testID]
+	var obf *sdulid.Obfuscator
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+
+		var err error
+		obf, err = sdulid.NewObfuscator([]byte("0123456789abcdef"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should round trip", func() {
+		token := sdulid.Obfuscate(obf, id1)
+		Expect(token).To(HavePrefix("tst_"))
+		Expect(token).To(HaveLen(4 + 26))
+		Expect(token).ToNot(ContainSubstring("01JBRQS1J5"))
+		Expect(sdulid.Obfuscate(obf, id1)).To(Equal(token))
+
+		id2, err := sdulid.Deobfuscate[testID](obf, token)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(id2).To(Equal(id1))
+	})
+
+	It("should hide the order of ids", func() {
+		id2 := sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M8ZXWG00")
+		Expect(sdulid.Obfuscate(obf, id1)[:10]).ToNot(Equal(sdulid.Obfuscate(obf, id2)[:10]))
+	})
+
+	It("should reject invalid tokens", func() {
+		token := sdulid.Obfuscate(obf, id1)
+
+		other, err := sdulid.NewObfuscator([]byte("fedcba9876543210"))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = sdulid.Deobfuscate[testID](other, token)
+		Expect(err).To(MatchError(sdulid.ErrInvalidToken))
+
+		_, err = sdulid.Deobfuscate[otherID](obf, "oth_"+token[4:])
+		Expect(err).To(MatchError(sdulid.ErrInvalidToken))
+
+		_, err = sdulid.Deobfuscate[otherID](obf, token)
+		Expect(err).To(MatchError(sdulid.ErrInvalidToken))
+
+		_, err = sdulid.Deobfuscate[testID](obf, "tst_01JBRQS1J5A085FYY2M7ZXXZ")
+		Expect(err).To(MatchError(sdulid.ErrInvalidToken))
+	})
+
+	It("should require a valid key", func() {
+		_, err := sdulid.NewObfuscator([]byte("short"))
+		Expect(err).To(MatchError(ContainSubstring("invalid key size")))
+	})
+})
@@ -0,0 +1,28 @@
+package sdulid
+
+import "time"
+
+// Layouts for ObjectKey that bucket objects by the time embedded in their ID. They are time.Format
+// layouts, so any other layout works too.
+const (
+	ObjectKeyMonthly = "2006/01"
+	ObjectKeyDaily   = "2006/01/02"
+	ObjectKeyHourly  = "2006/01/02/15"
+)
+
+// ObjectKey returns an object store key for id that is bucketed by the time embedded in it. The key
+// consists of the short ident, the UTC time of the id formatted with layout, and the prefixed text
+// form, separated by slashes. E.g. ObjectKey(id, ObjectKeyDaily)+".json" returns
+// "acc/2024/11/03/acc_01JBRQS1J5A085FYY2M7ZXXZ.json". This keeps listings balanced and allows
+// lifecycle rules to target date prefixes, see ObjectKeyPrefix.
+func ObjectKey[T Kind](id ID[T], layout string) string {
+	return ObjectKeyPrefix[T](id.Time(), layout) + id.String()
+}
+
+// ObjectKeyPrefix returns the prefix that ObjectKey gives the keys of all IDs of kind T in the bucket of
+// t, including the trailing slash. E.g. to list them or to target them with a lifecycle rule.
+func ObjectKeyPrefix[T Kind](t time.Time, layout string) string {
+	var kind T
+
+	return kind.KindShortIdent() + "/" + t.UTC().Format(layout) + "/"
+}
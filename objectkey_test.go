@@ -0,0 +1,29 @@
+package sdulid_test
+
+import (
+	"time"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("object key", func() {
+	id1 := sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+
+	DescribeTable("layouts",
+		func(layout, expKey string) {
+			Expect(sdulid.ObjectKey(id1, layout)).To(Equal(expKey))
+		},
+		Entry("monthly", sdulid.ObjectKeyMonthly, "tst/2024/11/tst_01JBRQS1J5A085FYY2M7ZXXZ"),
+		Entry("daily", sdulid.ObjectKeyDaily, "tst/2024/11/03/tst_01JBRQS1J5A085FYY2M7ZXXZ"),
+		Entry("hourly", sdulid.ObjectKeyHourly, "tst/2024/11/03/10/tst_01JBRQS1J5A085FYY2M7ZXXZ"),
+	)
+
+	It("should share the prefix of the bucket", func() {
+		loc := time.FixedZone("UTC+14", 14*60*60)
+		prefix := sdulid.ObjectKeyPrefix[testID](id1.Time().In(loc), sdulid.ObjectKeyDaily)
+		Expect(prefix).To(Equal("tst/2024/11/03/"))
+		Expect(sdulid.ObjectKey(id1, sdulid.ObjectKeyDaily)).To(HavePrefix(prefix))
+	})
+})
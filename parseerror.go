@@ -0,0 +1,148 @@
+package sdulid
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// ErrParse is matched (using errors.Is) by every error that is returned when an ID can't be parsed,
+// such as a *ParseError, a *WrongKindError, ErrNoPrefix, ErrInvalidSuffix and ErrBufferSize. Use it to
+// tell any parse failure apart from other errors, and ErrorCode to branch on the specific cause.
+var ErrParse = errors.New("sdulid: parse error")
+
+// ParseErrorCode classifies why text couldn't be parsed into an ID, e.g. to pick the problem type of
+// an API response.
+type ParseErrorCode string
+
+const (
+	// ParseErrorDataSize is the code for text that is too short or too long.
+	ParseErrorDataSize ParseErrorCode = "data_size"
+	// ParseErrorInvalidCharacters is the code for text with characters outside the alphabet.
+	ParseErrorInvalidCharacters ParseErrorCode = "invalid_characters"
+	// ParseErrorOverflow is the code for text that encodes a value above the 128 bit maximum.
+	ParseErrorOverflow ParseErrorCode = "overflow"
+	// ParseErrorInvalidSuffix is the code for text of which the trailing bits describe another kind.
+	ParseErrorInvalidSuffix ParseErrorCode = "invalid_suffix"
+	// ParseErrorWrongKind is the code for text that is prefixed with the short ident of another kind.
+	ParseErrorWrongKind ParseErrorCode = "wrong_kind"
+	// ParseErrorNoPrefix is the code for text without a prefix that isn't in the long form either.
+	ParseErrorNoPrefix ParseErrorCode = "no_prefix"
+	// ParseErrorBufferSize is the code for a buffer that doesn't fit the text form.
+	ParseErrorBufferSize ParseErrorCode = "buffer_size"
+	// ParseErrorInvalid is the code for any other reason.
+	ParseErrorInvalid ParseErrorCode = "invalid"
+)
+
+// maxParseErrorInput is the maximum number of bytes of the input that a ParseError holds.
+const maxParseErrorInput = 64
+
+// ParseError is returned when text can't be parsed into an ID. It unwraps to the underlying error,
+// such as ErrInvalidSuffix or ulid.ErrOverflow, so errors.Is keeps working.
+type ParseError struct {
+	// Input is the offending input. It is truncated to 64 bytes, and when log redaction is enabled
+	// (see SetLogRedaction) only the prefix and the timestamp characters are kept.
+	Input string
+	// Offset is the byte offset of the first offending byte in the input.
+	Offset int
+	// Expected is the short ident of the kind that the input was parsed as.
+	Expected string
+	// Code classifies the error.
+	Code ParseErrorCode
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("sdulid: failed to parse %q at offset %d: %v", e.Input, e.Offset, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrParse.
+func (e *ParseError) Is(target error) bool {
+	return target == ErrParse
+}
+
+// ParseErrorCode returns the code of the error.
+func (e *ParseError) ParseErrorCode() ParseErrorCode {
+	return e.Code
+}
+
+// codedError is a sentinel error with a code, it matches ErrParse.
+type codedError struct {
+	code ParseErrorCode
+	msg  string
+}
+
+func (e *codedError) Error() string {
+	return e.msg
+}
+
+// Is reports whether target is ErrParse.
+func (e *codedError) Is(target error) bool {
+	return target == ErrParse
+}
+
+// ParseErrorCode returns the code of the error.
+func (e *codedError) ParseErrorCode() ParseErrorCode {
+	return e.code
+}
+
+// ErrorCode returns the code of the first error in err's tree that has one, such as a *ParseError or
+// ErrInvalidSuffix. It returns an empty code when err is not a parse error.
+func ErrorCode(err error) ParseErrorCode {
+	var coded interface{ ParseErrorCode() ParseErrorCode }
+	if errors.As(err, &coded) {
+		return coded.ParseErrorCode()
+	}
+
+	return ""
+}
+
+// newParseError describes err that occurred while parsing input, of which the text form (after the
+// prefix) starts at start.
+func newParseError[S ~string | ~[]byte](input S, start int, expected string, err error) *ParseError {
+	perr := &ParseError{Offset: start, Expected: expected, Err: err, Code: ParseErrorInvalid}
+
+	switch {
+	case errors.Is(err, ErrWrongKind):
+		perr.Offset, perr.Code = 0, ParseErrorWrongKind
+	case errors.Is(err, ErrNoPrefix):
+		perr.Offset, perr.Code = 0, ParseErrorNoPrefix
+	case errors.Is(err, ulid.ErrDataSize):
+		perr.Offset, perr.Code = start+min(len(input)-start, textSize), ParseErrorDataSize
+	case errors.Is(err, ulid.ErrOverflow):
+		perr.Code = ParseErrorOverflow
+	case errors.Is(err, ErrInvalidSuffix):
+		perr.Offset, perr.Code = start+textSize-2, ParseErrorInvalidSuffix
+	case errors.Is(err, ulid.ErrInvalidCharacters):
+		perr.Code = ParseErrorInvalidCharacters
+
+		dec := decoder()
+		for i := start; i < len(input); i++ {
+			if dec[input[i]] == 0xFF {
+				perr.Offset = i
+
+				break
+			}
+		}
+	}
+
+	keep := maxParseErrorInput
+	if GetLogRedaction() {
+		keep = start + redactedKeep
+	}
+
+	if len(input) > keep {
+		perr.Input = string(input[:keep]) + "…"
+	} else {
+		perr.Input = string(input)
+	}
+
+	return perr
+}
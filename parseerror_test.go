@@ -0,0 +1,88 @@
+package sdulid_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/advdv/sdulid"
+	"github.com/oklog/ulid/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("parse error", func() {
+	DescribeTable("code and offset",
+		func(s string, expCode sdulid.ParseErrorCode, expOffset int, expErr error) {
+			for _, err := range []error{
+				func() error { _, err := sdulid.Parse[testID](s); return err }(),
+				sdulid.Validate[testID](s),
+			} {
+				var perr *sdulid.ParseError
+				Expect(errors.As(err, &perr)).To(BeTrue())
+				Expect(perr.Code).To(Equal(expCode))
+				Expect(perr.Offset).To(Equal(expOffset))
+				Expect(perr.Expected).To(Equal("tst"))
+				Expect(perr.Input).To(Equal(s))
+				Expect(err).To(MatchError(expErr))
+			}
+		},
+		Entry("too short", "tst_01JBRQS1J5", sdulid.ParseErrorDataSize, 14, ulid.ErrDataSize),
+		Entry("too long", "tst_01JBRQS1J5A085FYY2M7ZXXZZ", sdulid.ParseErrorDataSize, 28, ulid.ErrDataSize),
+		Entry("invalid character", "tst_01JBRQS1J5A0*5FYY2M7ZXXZ", sdulid.ParseErrorInvalidCharacters, 16,
+			ulid.ErrInvalidCharacters),
+		Entry("overflow", "tst_81JBRQS1J5A085FYY2M7ZXXZ", sdulid.ParseErrorOverflow, 4, ulid.ErrOverflow),
+		Entry("invalid suffix", "tst_01JBRQS1J5A085FYY2M7ZXW0", sdulid.ParseErrorInvalidSuffix, 26,
+			sdulid.ErrInvalidSuffix),
+		Entry("long invalid suffix", "01JBRQS1J5A085FYY2M7ZXW001", sdulid.ParseErrorInvalidSuffix, 22,
+			sdulid.ErrInvalidSuffix),
+		Entry("wrong kind", "oth_01JBRQS1J5A085FYY2M7ZXW0", sdulid.ParseErrorWrongKind, 0, sdulid.ErrWrongKind),
+		Entry("no prefix", "01JBRQS1J5", sdulid.ParseErrorNoPrefix, 0, sdulid.ErrNoPrefix),
+	)
+
+	It("should truncate long input", func() {
+		_, err := sdulid.Parse[testID]("tst_" + string(make([]byte, 100)))
+
+		var perr *sdulid.ParseError
+		Expect(errors.As(err, &perr)).To(BeTrue())
+		Expect(perr.Input).To(HaveLen(64 + len("…")))
+	})
+
+	It("should redact the input", func() {
+		DeferCleanup(sdulid.SetLogRedaction, sdulid.GetLogRedaction())
+		sdulid.SetLogRedaction(true)
+
+		_, err := sdulid.Parse[testID]("tst_01JBRQS1J5A085FYY2M7ZXW0")
+
+		var perr *sdulid.ParseError
+		Expect(errors.As(err, &perr)).To(BeTrue())
+		Expect(perr.Input).To(Equal("tst_01JBRQS1J5…"))
+		Expect(err).To(MatchError(`sdulid: failed to parse "tst_01JBRQS1J5…" at offset 26: ` +
+			`sdulid: invalid ulid suffix`))
+	})
+})
+
+var _ = Describe("parse error hierarchy", func() {
+	DescribeTable("errors",
+		func(err error, expCode sdulid.ParseErrorCode) {
+			Expect(err).To(MatchError(sdulid.ErrParse))
+			Expect(sdulid.ErrorCode(err)).To(Equal(expCode))
+		},
+		Entry("no prefix", sdulid.ErrNoPrefix, sdulid.ParseErrorNoPrefix),
+		Entry("invalid suffix", sdulid.ErrInvalidSuffix, sdulid.ParseErrorInvalidSuffix),
+		Entry("buffer size", sdulid.ErrBufferSize, sdulid.ParseErrorBufferSize),
+		Entry("wrong kind", sdulid.ErrWrongKind, sdulid.ParseErrorWrongKind),
+		Entry("wrong kind error", &sdulid.WrongKindError{Expected: "tst", Actual: "oth"}, sdulid.ParseErrorWrongKind),
+		Entry("wrapped", fmt.Errorf("failed to decode: %w", sdulid.ErrInvalidSuffix), sdulid.ParseErrorInvalidSuffix),
+		Entry("parse error", sdulid.Validate[testID]("tst_81JBRQS1J5A085FYY2M7ZXXZ"), sdulid.ParseErrorOverflow),
+	)
+
+	It("should not match other errors", func() {
+		Expect(sdulid.ErrScanValue).ToNot(MatchError(sdulid.ErrParse))
+		Expect(sdulid.ErrorCode(sdulid.ErrScanValue)).To(BeEmpty())
+	})
+
+	It("should keep specific causes apart", func() {
+		Expect(sdulid.ErrNoPrefix).ToNot(MatchError(sdulid.ErrInvalidSuffix))
+		Expect(sdulid.Validate[testID]("oth_01JBRQS1J5A085FYY2M7ZXW0")).To(MatchError(sdulid.ErrWrongKind))
+	})
+})
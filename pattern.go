@@ -0,0 +1,49 @@
+package sdulid
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// PatternFor returns an anchored regular expression that matches the prefixed text form of IDs of
+// kind T, e.g: "^tst_[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{23}$". It is the same pattern as in the JSON
+// Schema and can be used by validation layers that run before the ID is parsed. Like parsing, it
+// accepts the ULID in both upper and lower case.
+func PatternFor[T Kind]() string {
+	var kind T
+
+	return PatternForKind(kind)
+}
+
+// PatternForKind is like PatternFor but for a kind that is only known at runtime.
+func PatternForKind(kind Kind) string {
+	return pattern(kind)
+}
+
+// ProtovalidateRules returns the protovalidate string rules that enforce the prefixed text form of
+// IDs of kind T on a string field, for use in a proto file as:
+//
+//	string account_id = 1 [(buf.validate.field).string = {pattern: "^acc_...$", len: 28}];
+func ProtovalidateRules[T Kind]() string {
+	var kind T
+
+	return ProtovalidateRulesFor(kind)
+}
+
+// ProtovalidateRulesFor is like ProtovalidateRules but for a kind that is only known at runtime.
+func ProtovalidateRulesFor(kind Kind) string {
+	return fmt.Sprintf("{pattern: %s, len: %d}", strconv.Quote(pattern(kind)), len(kind.KindShortIdent())+1+textSize)
+}
+
+// CELRule returns a CEL expression that holds when the string "this" is in the prefixed text form of
+// IDs of kind T. It can be used in protovalidate's custom rules or other CEL based validation.
+func CELRule[T Kind]() string {
+	var kind T
+
+	return CELRuleFor(kind)
+}
+
+// CELRuleFor is like CELRule but for a kind that is only known at runtime.
+func CELRuleFor(kind Kind) string {
+	return "this.matches(" + strconv.Quote(pattern(kind)) + ")"
+}
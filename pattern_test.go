@@ -0,0 +1,33 @@
+package sdulid_test
+
+import (
+	"regexp"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("pattern", func() {
+	It("should match the prefixed form", func() {
+		Expect(sdulid.PatternFor[testID]()).To(Equal("^tst_[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{23}$"))
+		Expect(sdulid.PatternForKind(otherID{})).To(Equal("^oth_[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{23}$"))
+
+		re := regexp.MustCompile(sdulid.PatternFor[testID]())
+		Expect(re.MatchString(sdulid.Make[testID]().String())).To(BeTrue())
+		Expect(re.MatchString(sdulid.Make[otherID]().String())).To(BeFalse())
+		Expect(re.MatchString("tst_01jbrqs1j5a085fyy2m7zxxz")).To(BeTrue())
+		Expect(sdulid.MustParse[testID]("tst_01jbrqs1j5a085fyy2m7zxxz").String()).To(Equal("tst_01JBRQS1J5A085FYY2M7ZXXZ"))
+		Expect(re.MatchString("tst_01JBRQS1J5A085FYY2M7ZXXU")).To(BeFalse())
+		Expect(re.MatchString("tst_81JBRQS1J5A085FYY2M7ZXXZ")).To(BeFalse())
+		Expect(re.MatchString("tst_01JBRQS1J5A085FYY2M7ZXXZZZ")).To(BeFalse())
+	})
+
+	It("should render protovalidate and CEL rules", func() {
+		Expect(sdulid.ProtovalidateRules[testID]()).To(Equal(
+			`{pattern: "^tst_[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{23}$", len: 28}`))
+		Expect(sdulid.ProtovalidateRulesFor(otherID{})).To(HavePrefix(`{pattern: "^oth_`))
+		Expect(sdulid.CELRule[testID]()).To(Equal(`this.matches("^tst_[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{23}$")`))
+		Expect(sdulid.CELRuleFor(otherID{})).To(HavePrefix(`this.matches("^oth_`))
+	})
+})
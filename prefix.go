@@ -0,0 +1,32 @@
+package sdulid
+
+import (
+	"reflect"
+	"sync"
+)
+
+// shortIdenter is implemented by the kinds of all ID widths: Kind, KindNarrow and KindWide.
+type shortIdenter interface {
+	KindShortIdent() string
+}
+
+// prefixes holds a sync.OnceValue per kind type that builds its text prefix.
+var prefixes sync.Map // map[reflect.Type]func() []byte
+
+// prefixOf returns the text prefix of kind T: its short ident followed by an underscore. The prefix is
+// built once per kind, so encoding millions of IDs doesn't rebuild it from the short ident every time.
+// The returned slice is shared and must not be modified.
+func prefixOf[T shortIdenter]() []byte {
+	typ := reflect.TypeFor[T]()
+	if build, ok := prefixes.Load(typ); ok {
+		return build.(func() []byte)() //nolint:forcetypeassert
+	}
+
+	build, _ := prefixes.LoadOrStore(typ, sync.OnceValue(func() []byte {
+		var kind T
+
+		return []byte(kind.KindShortIdent() + "_")
+	}))
+
+	return build.(func() []byte)() //nolint:forcetypeassert
+}
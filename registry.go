@@ -0,0 +1,229 @@
+package sdulid
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/oklog/ulid/v2"
+)
+
+var (
+	// ErrUnknownKind is returned when an ID is parsed that describes a kind that is not registered.
+	ErrUnknownKind = errors.New("sdulid: unknown kind")
+	// ErrKindCollision is returned when kinds share the same kind number or short ident.
+	ErrKindCollision = errors.New("sdulid: kind collision")
+)
+
+// Registry holds kinds that are known at runtime. It allows IDs to be parsed without knowing their
+// kind statically. It is safe for concurrent use.
+type Registry struct {
+	mu       sync.RWMutex
+	byNumber map[uint16]Kind
+	byShort  map[string]Kind
+	reserved []Reservation
+	retired  map[uint16]bool
+}
+
+// NewRegistry inits a registry with the provided kinds registered. It returns an error when the
+// kinds collide.
+func NewRegistry(kinds ...Kind) (*Registry, error) {
+	reg := &Registry{
+		byNumber: map[uint16]Kind{},
+		byShort:  map[string]Kind{},
+		retired:  map[uint16]bool{},
+	}
+
+	if err := reg.Register(kinds...); err != nil {
+		return nil, err
+	}
+
+	return reg, nil
+}
+
+// MustNewRegistry is like NewRegistry but panics when the kinds collide.
+func MustNewRegistry(kinds ...Kind) *Registry {
+	reg, err := NewRegistry(kinds...)
+	if err != nil {
+		panic(err)
+	}
+
+	return reg
+}
+
+// Register adds kinds to the registry. If any of the kinds is invalid, collides with each other or
+// with kinds that are already registered, or lands in a retired number or a range reserved for another
+// owner, none of them are registered and an error is returned. Registering the same kind twice is not
+// considered a collision.
+func (r *Registry) Register(kinds ...Kind) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkNumbers(kinds); err != nil {
+		return err
+	}
+
+	return r.add(kinds)
+}
+
+// add validates the kinds against the registered kinds and adds them. The caller must hold the lock.
+func (r *Registry) add(kinds []Kind) error {
+	if err := ValidateKinds(append(slices.Collect(maps.Values(r.byNumber)), kinds...)...); err != nil {
+		return err
+	}
+
+	for _, kind := range kinds {
+		r.byNumber[kind.KindNumber()] = kind
+		for _, short := range shortIdents(kind) {
+			r.byShort[short] = kind
+		}
+	}
+
+	return nil
+}
+
+// ValidateKinds checks each kind with ValidateKind and the kinds for duplicate kind numbers and
+// duplicate short idents, including aliases. All problems are reported in the returned error. It can be used to check a
+// set of kinds without a registry.
+func ValidateKinds(kinds ...Kind) error {
+	var errs []error
+
+	byNumber, byShort := map[uint16]Kind{}, map[string]Kind{}
+	for _, kind := range kinds {
+		if err := ValidateKind(kind); err != nil {
+			errs = append(errs, err)
+		}
+
+		if other, ok := byNumber[kind.KindNumber()]; ok && !sameKind(kind, other) {
+			errs = append(errs, fmt.Errorf("%w: kind number %d of %q is already used by %q",
+				ErrKindCollision, kind.KindNumber(), kind.KindIdent(), other.KindIdent()))
+		} else if !ok {
+			byNumber[kind.KindNumber()] = kind
+		}
+
+		for _, short := range shortIdents(kind) {
+			if other, ok := byShort[short]; ok && !sameKind(kind, other) {
+				errs = append(errs, fmt.Errorf("%w: short ident %q of %q is already used by %q",
+					ErrKindCollision, short, kind.KindIdent(), other.KindIdent()))
+			} else if !ok {
+				byShort[short] = kind
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sameKind reports whether a and b describe the same kind.
+func sameKind(a, b Kind) bool {
+	return a.KindNumber() == b.KindNumber() &&
+		a.KindIdent() == b.KindIdent() &&
+		a.KindShortIdent() == b.KindShortIdent()
+}
+
+// Kinds returns all registered kinds, ordered by kind number.
+func (r *Registry) Kinds() []Kind {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	kinds := slices.Collect(maps.Values(r.byNumber))
+	slices.SortFunc(kinds, func(a, b Kind) int {
+		return cmp.Compare(a.KindNumber(), b.KindNumber())
+	})
+
+	return kinds
+}
+
+// LookupNumber returns the registered kind with the given kind number.
+func (r *Registry) LookupNumber(n uint16) (Kind, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	kind, ok := r.byNumber[n]
+
+	return kind, ok
+}
+
+// LookupShortIdent returns the registered kind with the given short ident, or deprecated alias.
+func (r *Registry) LookupShortIdent(s string) (Kind, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	kind, ok := r.byShort[s]
+
+	return kind, ok
+}
+
+// LookupIdent returns the registered kind with the given (long) ident.
+func (r *Registry) LookupIdent(s string) (Kind, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, kind := range r.byNumber {
+		if kind.KindIdent() == s {
+			return kind, true
+		}
+	}
+
+	return nil, false
+}
+
+// ParseAny parses s into an ID of which the kind is detected at runtime. For the prefixed form the
+// kind is detected from the prefix, for the long form it is detected from the two trailing bytes.
+func (r *Registry) ParseAny(s string) (id AnyID, kind Kind, err error) {
+	before, after, found := bytes.Cut([]byte(s), []byte("_"))
+	if !found && len(before) == ulid.EncodedSize {
+		if err := decodeLong(&id.u, before); err != nil {
+			return id, nil, err
+		}
+
+		num := binary.BigEndian.Uint16(id.u[14:])
+
+		kind, ok := r.LookupNumber(num)
+		if !ok {
+			return id, nil, fmt.Errorf("%w: kind number %d", ErrUnknownKind, num)
+		}
+
+		id.kind = kind
+
+		return id, kind, nil
+	} else if !found {
+		return id, nil, ErrNoPrefix
+	}
+
+	kind, ok := r.LookupShortIdent(string(before))
+	if !ok {
+		return id, nil, fmt.Errorf("%w: prefix %q", ErrUnknownKind, before)
+	}
+
+	if string(before) != kind.KindShortIdent() {
+		notifyAlias(kind, string(before))
+	}
+
+	if err := decodeText(&id.u, after, kind.KindNumber()); err != nil {
+		return id, nil, err
+	}
+
+	id.kind = kind
+
+	return id, kind, nil
+}
+
+// UnmarshalAny decodes the prefixed or long text form in data into id, resolving the kind through the
+// registry like ParseAny. Use it to decode AnyIDs, which can't decode themselves, e.g. after they were
+// encoded as JSON in an audit log.
+func (r *Registry) UnmarshalAny(data []byte, id *AnyID) error {
+	parsed, _, err := r.ParseAny(string(data))
+	if err != nil {
+		return err
+	}
+
+	*id = parsed
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package sdulid_test
+
+import (
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type sameNumberID struct{}
+
+func (sameNumberID) KindNumber() uint16     { return 1 }
+func (sameNumberID) KindIdent() string      { return "samenumber" }
+func (sameNumberID) KindShortIdent() string { return "smn" }
+
+type sameShortID struct{}
+
+func (sameShortID) KindNumber() uint16     { return 2 }
+func (sameShortID) KindIdent() string      { return "sameshort" }
+func (sameShortID) KindShortIdent() string { return "tst" }
+
+var _ = Describe("registry", func() {
+	var reg *sdulid.Registry
+
+	BeforeEach(func() {
+		reg = sdulid.MustNewRegistry(testID{}, otherID{})
+	})
+
+	It("should list kinds ordered by number", func() {
+		Expect(reg.Kinds()).To(Equal([]sdulid.Kind{otherID{}, testID{}}))
+	})
+
+	It("should allow registering the same kind twice", func() {
+		Expect(reg.Register(testID{})).To(Succeed())
+		Expect(reg.Kinds()).To(HaveLen(2))
+	})
+
+	DescribeTable("collisions",
+		func(kind sdulid.Kind, expMsg string) {
+			err := reg.Register(kind)
+			Expect(err).To(MatchError(sdulid.ErrKindCollision))
+			Expect(err).To(MatchError(ContainSubstring(expMsg)))
+			Expect(reg.Kinds()).To(HaveLen(2))
+		},
+		Entry("same number", sameNumberID{}, `kind number 1 of "samenumber" is already used by "other"`),
+		Entry("same short ident", sameShortID{}, `short ident "tst" of "sameshort" is already used by "test"`),
+	)
+
+	It("should validate without a registry", func() {
+		Expect(sdulid.ValidateKinds(testID{}, otherID{})).To(Succeed())
+
+		err := sdulid.ValidateKinds(testID{}, sameNumberID{}, otherID{}, sameShortID{})
+		Expect(err).To(MatchError(sdulid.ErrKindCollision))
+		Expect(err).To(MatchError(ContainSubstring(`kind number 1 of "other"`)))
+		Expect(err).To(MatchError(ContainSubstring(`short ident "tst" of "sameshort"`)))
+	})
+
+	It("should panic on collisions when constructing", func() {
+		Expect(func() {
+			sdulid.MustNewRegistry(otherID{}, sameNumberID{})
+		}).To(PanicWith(MatchError(sdulid.ErrKindCollision)))
+	})
+
+	It("should lookup kinds", func() {
+		kind, ok := reg.LookupNumber(1)
+		Expect(ok).To(BeTrue())
+		Expect(kind).To(Equal(otherID{}))
+
+		kind, ok = reg.LookupShortIdent("tst")
+		Expect(ok).To(BeTrue())
+		Expect(kind).To(Equal(testID{}))
+
+		_, ok = reg.LookupShortIdent("foo")
+		Expect(ok).To(BeFalse())
+
+		kind, ok = reg.LookupIdent("other")
+		Expect(ok).To(BeTrue())
+		Expect(kind).To(Equal(otherID{}))
+
+		_, ok = reg.LookupIdent("oth")
+		Expect(ok).To(BeFalse())
+	})
+
+	DescribeTable("parse any",
+		func(s string, expKind sdulid.Kind, expErr error) {
+			id, kind, err := reg.ParseAny(s)
+			if expErr != nil {
+				Expect(err).To(MatchError(expErr))
+
+				return
+			}
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kind).To(Equal(expKind))
+			Expect(id.Kind()).To(Equal(expKind))
+			Expect(id.Bytes()[6:14]).To(Equal([]byte{80, 16, 87, 251, 194, 161, 255, 222}))
+		},
+		Entry("prefixed test", "tst_01JBRQS1J5A085FYY2M7ZXXZ", testID{}, nil),
+		Entry("prefixed other", "oth_01JBRQS1J5A085FYY2M7ZXW0", otherID{}, nil),
+		Entry("long test", "01JBRQS1J5A085FYY2M7ZXXZZZ", testID{}, nil),
+		Entry("long other", "01JBRQS1J5A085FYY2M7ZXW001", otherID{}, nil),
+		Entry("unknown prefix", "foo_01JBRQS1J5A085FYY2M7ZXXZ", nil, sdulid.ErrUnknownKind),
+		Entry("unknown suffix", "01JBRQS1J5A085FYY2M7ZXW002", nil, sdulid.ErrUnknownKind),
+		Entry("no prefix", "01JBRQS1J5A085FYY2M7ZXXZ", nil, sdulid.ErrNoPrefix),
+	)
+})
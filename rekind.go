@@ -0,0 +1,78 @@
+package sdulid
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// ErrIncompatibleKinds is returned when an ID is rekinded strictly to a kind that is not compatible.
+var ErrIncompatibleKinds = errors.New("sdulid: incompatible kinds")
+
+// CompatibleKind is implemented by kinds that opt in to be rekinded strictly. Kinds are compatible when
+// they return the same, non-empty, compatibility group. E.g: when splitting a "user" kind into "member"
+// and "admin" all three kinds can return "user".
+type CompatibleKind interface {
+	Kind
+	KindCompatibility() string
+}
+
+// RekindHook is called when an ID is rekinded, with the kinds and the text forms before and after.
+type RekindHook func(from, to KindInfo, before, after string)
+
+// rekindHook holds the package-wide rekind hook.
+var rekindHook atomic.Pointer[RekindHook]
+
+// SetRekindHook configures fn to be called whenever an ID is rekinded by Rekind or RekindStrict, e.g.
+// to keep an audit trail of which IDs changed kind during a migration. A nil fn disables it. It is
+// safe for concurrent use but is meant to be called once during program initialization.
+func SetRekindHook(fn RekindHook) {
+	if fn == nil {
+		rekindHook.Store(nil)
+
+		return
+	}
+
+	rekindHook.Store(&fn)
+}
+
+// GetRekindHook returns the configured rekind hook, or nil if there is none.
+func GetRekindHook() RekindHook {
+	if fn := rekindHook.Load(); fn != nil {
+		return *fn
+	}
+
+	return nil
+}
+
+// Rekind converts an ID of kind From into an ID of kind To by rewriting the kind suffix. The time and
+// entropy are kept, but note that the text form changes since it includes the prefix and the suffix.
+// The rekind hook is called, if configured.
+func Rekind[From, To Kind](id ID[From]) (rid ID[To]) {
+	rid.ULID = id.ULID
+	rid.putSuffixBytes()
+
+	if fn := GetRekindHook(); fn != nil {
+		fn(KindOf[From](), KindOf[To](), id.String(), rid.String())
+	}
+
+	return rid
+}
+
+// RekindStrict is like Rekind but returns ErrIncompatibleKinds unless both kinds implement
+// CompatibleKind and share the same compatibility group. The rekind hook is only called on success.
+func RekindStrict[From, To Kind](id ID[From]) (ID[To], error) {
+	var from From
+
+	var to To
+
+	fromc, fok := any(from).(CompatibleKind)
+	toc, tok := any(to).(CompatibleKind)
+
+	if !fok || !tok || fromc.KindCompatibility() == "" ||
+		fromc.KindCompatibility() != toc.KindCompatibility() {
+		return ID[To]{}, fmt.Errorf("%w: %q to %q", ErrIncompatibleKinds, from.KindIdent(), to.KindIdent())
+	}
+
+	return Rekind[From, To](id), nil
+}
@@ -0,0 +1,75 @@
+package sdulid_test
+
+import (
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type userID struct{}
+
+func (userID) KindNumber() uint16        { return 10 }
+func (userID) KindIdent() string         { return "user" }
+func (userID) KindShortIdent() string    { return "usr" }
+func (userID) KindCompatibility() string { return "user" }
+
+type memberID struct{}
+
+func (memberID) KindNumber() uint16        { return 11 }
+func (memberID) KindIdent() string         { return "member" }
+func (memberID) KindShortIdent() string    { return "mbr" }
+func (memberID) KindCompatibility() string { return "user" }
+
+var _ = Describe("rekind", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should rewrite the suffix", func() {
+		id2 := sdulid.Rekind[testID, otherID](id1)
+		Expect(id2.String()).To(Equal("oth_01JBRQS1J5A085FYY2M7ZXW0"))
+		Expect(id2.Bytes()[:14]).To(Equal(id1.Bytes()[:14]))
+		Expect(sdulid.Rekind[otherID, testID](id2)).To(Equal(id1))
+	})
+
+	It("should rekind strictly between compatible kinds", func() {
+		uid := sdulid.Rekind[testID, userID](id1)
+
+		mid, err := sdulid.RekindStrict[userID, memberID](uid)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mid.Bytes()[14:]).To(Equal([]byte{0, 11}))
+	})
+
+	It("should refuse to rekind strictly between incompatible kinds", func() {
+		_, err := sdulid.RekindStrict[testID, otherID](id1)
+		Expect(err).To(MatchError(sdulid.ErrIncompatibleKinds))
+		Expect(err).To(MatchError(ContainSubstring(`"test" to "other"`)))
+
+		_, err = sdulid.RekindStrict[userID, testID](sdulid.Rekind[testID, userID](id1))
+		Expect(err).To(MatchError(sdulid.ErrIncompatibleKinds))
+	})
+
+	It("should report to the rekind hook", func() {
+		var calls []string
+		sdulid.SetRekindHook(func(from, to sdulid.KindInfo, before, after string) {
+			calls = append(calls, from.Ident+">"+to.Ident+":"+before+">"+after)
+		})
+		DeferCleanup(sdulid.SetRekindHook, sdulid.RekindHook(nil))
+
+		uid := sdulid.Rekind[testID, userID](id1)
+		_, err := sdulid.RekindStrict[userID, testID](uid)
+		Expect(err).To(HaveOccurred())
+		_, err = sdulid.RekindStrict[userID, memberID](uid)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(calls).To(Equal([]string{
+			"test>user:tst_01JBRQS1J5A085FYY2M7ZXXZ>usr_01JBRQS1J5A085FYY2M7ZXW0",
+			"user>member:usr_01JBRQS1J5A085FYY2M7ZXW0>mbr_01JBRQS1J5A085FYY2M7ZXW0",
+		}))
+
+		sdulid.SetRekindHook(nil)
+		Expect(sdulid.GetRekindHook()).To(BeNil())
+	})
+})
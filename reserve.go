@@ -0,0 +1,147 @@
+package sdulid
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var (
+	// ErrReservedKind is returned when a kind is registered with a number that is reserved for
+	// another owner.
+	ErrReservedKind = errors.New("sdulid: kind number is reserved")
+	// ErrRetiredKind is returned when a kind is registered with a number that is retired.
+	ErrRetiredKind = errors.New("sdulid: kind number is retired")
+)
+
+// Reservation reserves a range of kind numbers for an owner, such as a team or a service. It prevents
+// collisions between repositories that each define their own kinds.
+type Reservation struct {
+	// Owner identifies the team or service that the range is reserved for.
+	Owner string `json:"owner"`
+	// From is the first kind number of the range.
+	From uint16 `json:"from"`
+	// To is the last kind number of the range (inclusive).
+	To uint16 `json:"to"`
+}
+
+// Contains reports whether n falls in the reserved range.
+func (res Reservation) Contains(n uint16) bool {
+	return n >= res.From && n <= res.To
+}
+
+// OwnedKind is implemented by kinds that belong to an owner. Only kinds of the owner of a reservation
+// can be registered with a number in its range.
+type OwnedKind interface {
+	Kind
+	// KindOwner returns the team or service that the kind belongs to.
+	KindOwner() string
+}
+
+// kindOwner returns the owner of kind, or an empty string if it has none.
+func kindOwner(kind Kind) string {
+	if owned, ok := kind.(OwnedKind); ok {
+		return owned.KindOwner()
+	}
+
+	return ""
+}
+
+// Reserve reserves ranges of kind numbers. An error is returned when a range is empty, overlaps another
+// reservation or holds registered kinds of another owner, in which case none of them are reserved.
+func (r *Registry) Reserve(reservations ...Reservation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	all := slices.Concat(r.reserved, reservations)
+	slices.SortFunc(all, func(a, b Reservation) int { return cmp.Compare(a.From, b.From) })
+
+	var errs []error
+
+	for i, res := range all {
+		if res.From > res.To {
+			errs = append(errs, fmt.Errorf("%w: range %d-%d of %q is empty", ErrKindCollision, res.From, res.To, res.Owner))
+		}
+
+		if i > 0 && all[i-1].To >= res.From {
+			errs = append(errs, fmt.Errorf("%w: range %d-%d of %q overlaps range %d-%d of %q", ErrKindCollision,
+				res.From, res.To, res.Owner, all[i-1].From, all[i-1].To, all[i-1].Owner))
+		}
+	}
+
+	for _, kind := range r.byNumber {
+		errs = append(errs, checkReserved(reservations, kind))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	r.reserved = all
+
+	return nil
+}
+
+// Reservations returns the reserved ranges, ordered by their first kind number.
+func (r *Registry) Reservations() []Reservation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return slices.Clone(r.reserved)
+}
+
+// Retire registers kinds as retired: IDs of these kinds can still be parsed, but no new kind can be
+// registered with their numbers. Kinds that are already registered can be retired too.
+func (r *Registry) Retire(kinds ...Kind) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.add(kinds); err != nil {
+		return err
+	}
+
+	for _, kind := range kinds {
+		r.retired[kind.KindNumber()] = true
+	}
+
+	return nil
+}
+
+// IsRetired reports whether kind number n is retired, so IDs of it should only be parsed and never
+// be generated.
+func (r *Registry) IsRetired(n uint16) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.retired[n]
+}
+
+// checkNumbers checks that the kinds don't use retired numbers or numbers that are reserved for
+// another owner. The caller must hold the lock.
+func (r *Registry) checkNumbers(kinds []Kind) error {
+	var errs []error
+
+	for _, kind := range kinds {
+		if r.retired[kind.KindNumber()] {
+			errs = append(errs, fmt.Errorf("%w: kind number %d of %q was retired by %q", ErrRetiredKind,
+				kind.KindNumber(), kind.KindIdent(), r.byNumber[kind.KindNumber()].KindIdent()))
+		}
+
+		errs = append(errs, checkReserved(r.reserved, kind))
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkReserved checks that kind doesn't land in a range that is reserved for another owner.
+func checkReserved(reservations []Reservation, kind Kind) error {
+	for _, res := range reservations {
+		if res.Contains(kind.KindNumber()) && kindOwner(kind) != res.Owner {
+			return fmt.Errorf("%w: kind number %d of %q is in range %d-%d reserved for %q", ErrReservedKind,
+				kind.KindNumber(), kind.KindIdent(), res.From, res.To, res.Owner)
+		}
+	}
+
+	return nil
+}
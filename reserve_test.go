@@ -0,0 +1,64 @@
+package sdulid_test
+
+import (
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type billingID struct{}
+
+func (billingID) KindNumber() uint16     { return 100 }
+func (billingID) KindIdent() string      { return "invoice" }
+func (billingID) KindShortIdent() string { return "inv" }
+func (billingID) KindOwner() string      { return "billing" }
+
+var _ = Describe("reservations", func() {
+	var reg *sdulid.Registry
+
+	BeforeEach(func() {
+		reg = sdulid.MustNewRegistry(testID{})
+		Expect(reg.Reserve(
+			sdulid.Reservation{Owner: "search", From: 200, To: 299},
+			sdulid.Reservation{Owner: "billing", From: 100, To: 199},
+		)).To(Succeed())
+	})
+
+	It("should list reservations", func() {
+		Expect(reg.Reservations()).To(Equal([]sdulid.Reservation{
+			{Owner: "billing", From: 100, To: 199},
+			{Owner: "search", From: 200, To: 299},
+		}))
+	})
+
+	It("should only register kinds of the owner in a reserved range", func() {
+		Expect(reg.Register(billingID{})).To(Succeed())
+
+		err := reg.Register(runtimeKind{"order", "ord"})
+		Expect(err).To(MatchError(sdulid.ErrReservedKind))
+		Expect(err).To(MatchError(ContainSubstring(`kind number 100 of "order" is in range 100-199 reserved for "billing"`)))
+	})
+
+	DescribeTable("invalid reservations",
+		func(res sdulid.Reservation, expErr error) {
+			Expect(reg.Reserve(res)).To(MatchError(expErr))
+			Expect(reg.Reservations()).To(HaveLen(2))
+		},
+		Entry("overlap", sdulid.Reservation{Owner: "auth", From: 250, To: 350}, sdulid.ErrKindCollision),
+		Entry("empty", sdulid.Reservation{Owner: "auth", From: 400, To: 300}, sdulid.ErrKindCollision),
+		Entry("registered kind", sdulid.Reservation{Owner: "auth", From: 65535, To: 65535}, sdulid.ErrReservedKind),
+	)
+
+	It("should parse but not register retired kinds", func() {
+		Expect(reg.Retire(otherID{})).To(Succeed())
+		Expect(reg.IsRetired(1)).To(BeTrue())
+		Expect(reg.IsRetired(65535)).To(BeFalse())
+
+		_, kind, err := reg.ParseAny("oth_01JBRQS1J5A085FYY2M7ZXW0")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kind).To(Equal(otherID{}))
+
+		Expect(reg.Register(otherID{})).To(MatchError(sdulid.ErrRetiredKind))
+		Expect(reg.Register(sameNumberID{})).To(MatchError(sdulid.ErrRetiredKind))
+	})
+})
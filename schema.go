@@ -0,0 +1,70 @@
+package sdulid
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+)
+
+// exampleULID is used to render a realistic example ID in schemas.
+const exampleULID = "01JBRQS1J5A085FYY2M7ZXWG00"
+
+// schema is the subset of JSON Schema (draft 2020-12) that describes an ID.
+type schema struct {
+	Schema      string   `json:"$schema,omitempty"`
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description"`
+	Type        string   `json:"type"`
+	Pattern     string   `json:"pattern"`
+	MinLength   int      `json:"minLength"`
+	MaxLength   int      `json:"maxLength"`
+	Examples    []string `json:"examples"`
+	KindNumber  *uint16  `json:"x-sdulid-kind-number,omitempty"`
+}
+
+// pattern returns an anchored regular expression that matches the prefixed text form of kind.
+func pattern(kind Kind) string {
+	return fmt.Sprintf("^%s_[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{23}$", regexp.QuoteMeta(kind.KindShortIdent()))
+}
+
+// newSchema builds the schema that describes IDs of kind T.
+func newSchema[T Kind]() schema {
+	var kind T
+	example := MustFromULID[T](exampleULID)
+
+	return schema{
+		Title: kind.KindIdent() + "_id",
+		Description: fmt.Sprintf("Self-describing ULID that identifies a %s, prefixed with %q.",
+			kind.KindIdent(), kind.KindShortIdent()+"_"),
+		Type:      "string",
+		Pattern:   pattern(kind),
+		MinLength: example.EncodedSize(),
+		MaxLength: example.EncodedSize(),
+		Examples:  []string{example.String()},
+	}
+}
+
+// SchemaJSON returns a JSON Schema (draft 2020-12) that describes the prefixed text form of IDs of
+// kind T, including a pattern that is anchored on the kind's short ident.
+func SchemaJSON[T Kind]() string {
+	s := newSchema[T]()
+	s.Schema = "https://json-schema.org/draft/2020-12/schema"
+
+	data, _ := json.MarshalIndent(s, "", "  ")
+
+	return string(data)
+}
+
+// OpenAPISchemaJSON returns an OpenAPI 3.1 schema object that describes the prefixed text form of IDs
+// of kind T. Besides the JSON Schema keywords it includes the kind number as an extension.
+func OpenAPISchemaJSON[T Kind]() string {
+	var kind T
+
+	s := newSchema[T]()
+	num := kind.KindNumber()
+	s.KindNumber = &num
+
+	data, _ := json.MarshalIndent(s, "", "  ")
+
+	return string(data)
+}
@@ -0,0 +1,46 @@
+package sdulid_test
+
+import (
+	"encoding/json"
+	"regexp"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("schema", func() {
+	It("should generate json schema", func() {
+		Expect(sdulid.SchemaJSON[testID]()).To(MatchJSON(`{
+			"$schema": "https://json-schema.org/draft/2020-12/schema",
+			"title": "test_id",
+			"description": "Self-describing ULID that identifies a test, prefixed with \"tst_\".",
+			"type": "string",
+			"pattern": "^tst_[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{23}$",
+			"minLength": 28,
+			"maxLength": 28,
+			"examples": ["tst_01JBRQS1J5A085FYY2M7ZXXZ"]
+		}`))
+	})
+
+	It("should generate openapi schema", func() {
+		var s map[string]any
+		Expect(json.Unmarshal([]byte(sdulid.OpenAPISchemaJSON[otherID]()), &s)).To(Succeed())
+		Expect(s).ToNot(HaveKey("$schema"))
+		Expect(s).To(HaveKeyWithValue("x-sdulid-kind-number", BeNumerically("==", 1)))
+		Expect(s).To(HaveKeyWithValue("examples", ConsistOf("oth_01JBRQS1J5A085FYY2M7ZXW0")))
+	})
+
+	It("should match generated ids with the pattern", func() {
+		var s struct{ Pattern string }
+		Expect(json.Unmarshal([]byte(sdulid.SchemaJSON[testID]()), &s)).To(Succeed())
+
+		re := regexp.MustCompile(s.Pattern)
+		for range 100 {
+			Expect(re.MatchString(sdulid.Make[testID]().String())).To(BeTrue())
+		}
+
+		Expect(re.MatchString(sdulid.Make[otherID]().String())).To(BeFalse())
+		Expect(re.MatchString("tst_" + sdulid.Make[testID]().ULID.String())).To(BeFalse())
+	})
+})
@@ -0,0 +1,148 @@
+// Package sdulidarrow provides an Arrow extension type for self-describing ULIDs.
+//
+// IDs are stored as FIXED_SIZE_BINARY(16), so columns stay compact. The kind is kept in the extension
+// metadata, so readers such as analysts' notebooks can tell which entity a column refers to. Written
+// to Parquet (e.g. through pqarrow) the storage becomes a FIXED_LEN_BYTE_ARRAY(16) column. For
+// parquet-go, see the sdulidparquet package.
+package sdulidarrow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/advdv/sdulid"
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+)
+
+// ExtensionName is the name under which IDType is registered, it is written to the
+// ARROW:extension:name metadata key.
+const ExtensionName = "sdulid.id"
+
+// ErrKindMismatch is returned when an array holds IDs of another kind than requested.
+var ErrKindMismatch = errors.New("sdulidarrow: kind mismatch")
+
+// storageType is the storage type of every IDType.
+var storageType = &arrow.FixedSizeBinaryType{ByteWidth: 16}
+
+func init() {
+	if err := arrow.RegisterExtensionType(&IDType{ExtensionBase: arrow.ExtensionBase{Storage: storageType}}); err != nil {
+		panic(err)
+	}
+}
+
+// IDType is the Arrow extension type for IDs of one kind. The kind is serialized as JSON into the
+// ARROW:extension:metadata key.
+type IDType struct {
+	arrow.ExtensionBase
+	kind sdulid.ManifestKind
+}
+
+// NewIDType returns the extension type for IDs of kind T.
+func NewIDType[T sdulid.Kind]() *IDType {
+	var kind T
+
+	return NewIDTypeFor(kind)
+}
+
+// NewIDTypeFor is like NewIDType but for a kind that is only known at runtime.
+func NewIDTypeFor(kind sdulid.Kind) *IDType {
+	return &IDType{
+		ExtensionBase: arrow.ExtensionBase{Storage: storageType},
+		kind: sdulid.ManifestKind{
+			Number:     kind.KindNumber(),
+			Ident:      kind.KindIdent(),
+			ShortIdent: kind.KindShortIdent(),
+		},
+	}
+}
+
+// Kind returns the kind of the IDs.
+func (t *IDType) Kind() sdulid.Kind { return t.kind }
+
+// String returns the extension name and the kind ident.
+func (t *IDType) String() string {
+	return fmt.Sprintf("extension<%s[%s]>", ExtensionName, t.kind.Ident)
+}
+
+// ArrayType implements arrow.ExtensionType.
+func (*IDType) ArrayType() reflect.Type { return reflect.TypeOf(IDArray{}) }
+
+// ExtensionName implements arrow.ExtensionType.
+func (*IDType) ExtensionName() string { return ExtensionName }
+
+// ExtensionEquals implements arrow.ExtensionType, types are equal when their kinds are.
+func (t *IDType) ExtensionEquals(other arrow.ExtensionType) bool {
+	o, ok := other.(*IDType)
+
+	return ok && o.kind.Number == t.kind.Number && o.kind.Ident == t.kind.Ident &&
+		o.kind.ShortIdent == t.kind.ShortIdent
+}
+
+// Serialize implements arrow.ExtensionType by encoding the kind as JSON.
+func (t *IDType) Serialize() string {
+	data, _ := json.Marshal(t.kind) //nolint:errchkjson // plain struct, can't fail
+
+	return string(data)
+}
+
+// Deserialize implements arrow.ExtensionType by decoding the kind from JSON.
+func (*IDType) Deserialize(storage arrow.DataType, data string) (arrow.ExtensionType, error) {
+	if !arrow.TypeEqual(storage, storageType) {
+		return nil, fmt.Errorf("sdulidarrow: invalid storage type %s", storage)
+	}
+
+	var kind sdulid.ManifestKind
+	if err := json.Unmarshal([]byte(data), &kind); err != nil {
+		return nil, fmt.Errorf("failed to decode kind: %w", err)
+	}
+
+	return NewIDTypeFor(kind), nil
+}
+
+// IDArray is an array of IDType.
+type IDArray struct {
+	array.ExtensionArrayBase
+}
+
+// NewArray builds an array holding ids. The caller must release it.
+func NewArray[T sdulid.Kind](mem memory.Allocator, ids []sdulid.ID[T]) *IDArray {
+	bldr := array.NewExtensionBuilder(mem, NewIDType[T]())
+	defer bldr.Release()
+
+	storage := bldr.StorageBuilder().(*array.FixedSizeBinaryBuilder) //nolint:forcetypeassert
+	storage.Reserve(len(ids))
+
+	for _, id := range ids {
+		storage.Append(id.Bytes())
+	}
+
+	return bldr.NewExtensionArray().(*IDArray) //nolint:forcetypeassert
+}
+
+// IDs returns the IDs in arr. It returns ErrKindMismatch when arr holds IDs of another kind than T, and
+// an error when a value's suffix doesn't describe T. Null values are returned as the zero ID.
+func IDs[T sdulid.Kind](arr *IDArray) ([]sdulid.ID[T], error) {
+	var kind T
+	if !arr.ExtensionType().ExtensionEquals(NewIDTypeFor(kind)) {
+		return nil, fmt.Errorf("%w: expected %s, got %s", ErrKindMismatch, NewIDTypeFor(kind), arr.DataType())
+	}
+
+	storage := arr.Storage().(*array.FixedSizeBinary) //nolint:forcetypeassert
+	ids := make([]sdulid.ID[T], arr.Len())
+
+	for i := range ids {
+		if arr.IsNull(i) {
+			continue
+		}
+
+		if err := ids[i].UnmarshalBinary(storage.Value(i)); err != nil {
+			return nil, fmt.Errorf("failed to decode value %d: %w", i, err)
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,85 @@
+package sdulidarrow_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidarrow"
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSdulidarrow(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidarrow")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+type otherID struct{}
+
+func (otherID) KindNumber() uint16     { return 1 }
+func (otherID) KindIdent() string      { return "other" }
+func (otherID) KindShortIdent() string { return "oth" }
+
+var _ = Describe("arrow", func() {
+	var mem *memory.CheckedAllocator
+	var ids []sdulid.ID[testID]
+
+	BeforeEach(func() {
+		mem = memory.NewCheckedAllocator(memory.NewGoAllocator())
+		DeferCleanup(func() { Expect(mem.CurrentAlloc()).To(BeZero()) })
+
+		ids = []sdulid.ID[testID]{sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00"), sdulid.Make[testID]()}
+	})
+
+	It("should round-trip through ipc with the kind in the metadata", func() {
+		arr := sdulidarrow.NewArray(mem, ids)
+		defer arr.Release()
+
+		schema := arrow.NewSchema([]arrow.Field{{Name: "id", Type: arr.DataType()}}, nil)
+		rec := array.NewRecord(schema, []arrow.Array{arr}, int64(arr.Len()))
+		defer rec.Release()
+
+		var buf bytes.Buffer
+		w := ipc.NewWriter(&buf, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+		Expect(w.Write(rec)).To(Succeed())
+		Expect(w.Close()).To(Succeed())
+
+		r, err := ipc.NewReader(&buf, ipc.WithAllocator(mem))
+		Expect(err).ToNot(HaveOccurred())
+		defer r.Release()
+
+		Expect(r.Next()).To(BeTrue())
+		col, ok := r.Record().Column(0).(*sdulidarrow.IDArray)
+		Expect(ok).To(BeTrue())
+
+		typ, ok := col.DataType().(*sdulidarrow.IDType)
+		Expect(ok).To(BeTrue())
+		Expect(typ.Kind().KindShortIdent()).To(Equal("tst"))
+		Expect(typ.Serialize()).To(Equal(`{"number":65535,"ident":"test","short_ident":"tst"}`))
+
+		actual, err := sdulidarrow.IDs[testID](col)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(ids))
+	})
+
+	It("should reject another kind", func() {
+		arr := sdulidarrow.NewArray(mem, ids)
+		defer arr.Release()
+
+		_, err := sdulidarrow.IDs[otherID](arr)
+		Expect(err).To(MatchError(sdulidarrow.ErrKindMismatch))
+	})
+})
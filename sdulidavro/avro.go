@@ -0,0 +1,122 @@
+// Package sdulidavro maps self-describing ULIDs onto Avro.
+//
+// IDs are written as a fixed of 16 bytes instead of their text form, which roughly halves their size
+// in change data capture streams. The fixed carries the "sdulid" logical type and the kind as custom
+// properties, so consumers can tell which entity it refers to. Readers that don't know the logical
+// type fall back to the 16 bytes, as the Avro specification requires.
+package sdulidavro
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/advdv/sdulid"
+)
+
+// LogicalType is the logical type of the fixed that holds an ID.
+const LogicalType = "sdulid"
+
+// size is the size of the fixed, the 16 raw bytes of an ID.
+const size = 16
+
+// ErrInvalidNative is returned when a native value can't be decoded into an ID.
+var ErrInvalidNative = errors.New("sdulidavro: invalid native value")
+
+// fixedSchema is the schema fragment of a fixed holding an ID.
+type fixedSchema struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Size        int    `json:"size"`
+	LogicalType string `json:"logicalType"`
+	Kind        string `json:"kind"`
+	ShortIdent  string `json:"shortIdent"`
+	KindNumber  uint16 `json:"kindNumber"`
+}
+
+// Schema returns the schema fragment for IDs of kind T, for use as the type of a record field. The fixed
+// is named after the kind ident with an "_id" suffix, e.g. "account_id". Avro requires every later use
+// of it within the same schema to refer to it by that name.
+func Schema[T sdulid.Kind]() string {
+	var kind T
+
+	return SchemaFor(kind)
+}
+
+// SchemaFor is like Schema but for a kind that is only known at runtime.
+func SchemaFor(kind sdulid.Kind) string {
+	data, _ := json.Marshal(fixedSchema{ //nolint:errchkjson
+		Type:        "fixed",
+		Name:        name(kind),
+		Size:        size,
+		LogicalType: LogicalType,
+		Kind:        kind.KindIdent(),
+		ShortIdent:  kind.KindShortIdent(),
+		KindNumber:  kind.KindNumber(),
+	})
+
+	return string(data)
+}
+
+// ToNative returns the native value of id for goavro's BinaryFromNative and TextualFromNative.
+func ToNative[T sdulid.Kind](id sdulid.ID[T]) any {
+	return id.Bytes()
+}
+
+// FromNative returns the ID of kind T held by the native value v, as returned by goavro's
+// NativeFromBinary and NativeFromTextual. It returns an error when v isn't 16 bytes, or when the suffix
+// doesn't describe T.
+func FromNative[T sdulid.Kind](v any) (id sdulid.ID[T], err error) {
+	b, ok := v.([]byte)
+	if !ok {
+		return id, fmt.Errorf("%w: expected []byte, got %T", ErrInvalidNative, v)
+	}
+
+	return sdulid.FromBytes[T](b)
+}
+
+// NullToNative returns the native value of n for a union of null and the fixed of kind T, as declared
+// by NullSchema.
+func NullToNative[T sdulid.Kind](n sdulid.NullID[T]) any {
+	if !n.Valid {
+		return nil
+	}
+
+	var kind T
+
+	return map[string]any{name(kind): ToNative(n.ID)}
+}
+
+// NullFromNative is like FromNative but for the native value of a union of null and the fixed of kind
+// T, as declared by NullSchema.
+func NullFromNative[T sdulid.Kind](v any) (n sdulid.NullID[T], err error) {
+	if v == nil {
+		return n, nil
+	}
+
+	var kind T
+
+	union, ok := v.(map[string]any)
+	if !ok || len(union) != 1 || union[name(kind)] == nil {
+		return n, fmt.Errorf("%w: expected union with %q, got %v", ErrInvalidNative, name(kind), v)
+	}
+
+	if n.ID, err = FromNative[T](union[name(kind)]); err != nil {
+		return n, err
+	}
+
+	n.Valid = true
+
+	return n, nil
+}
+
+// NullSchema returns the schema fragment for nullable IDs of kind T: a union of null and the fixed of
+// Schema. Use it as the type of a field with a null default.
+func NullSchema[T sdulid.Kind]() string {
+	return `["null",` + Schema[T]() + `]`
+}
+
+// name returns the name of the fixed holding IDs of kind.
+func name(kind sdulid.Kind) string {
+	return kind.KindIdent() + "_id"
+}
@@ -0,0 +1,81 @@
+package sdulidavro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidavro"
+	"github.com/linkedin/goavro/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSdulidavro(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidavro")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+type otherID struct{}
+
+func (otherID) KindNumber() uint16     { return 1 }
+func (otherID) KindIdent() string      { return "other" }
+func (otherID) KindShortIdent() string { return "oth" }
+
+var _ = Describe("avro", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should generate the schema fragment", func() {
+		Expect(sdulidavro.Schema[testID]()).To(Equal(`{"type":"fixed","name":"test_id","size":16,` +
+			`"logicalType":"sdulid","kind":"test","shortIdent":"tst","kindNumber":65535}`))
+	})
+
+	It("should round-trip through goavro", func() {
+		codec, err := goavro.NewCodec(`{"type":"record","name":"change","fields":[` +
+			`{"name":"id","type":` + sdulidavro.Schema[testID]() + `},` +
+			`{"name":"parent","type":` + sdulidavro.NullSchema[testID]() + `,"default":null}]}`)
+		Expect(err).ToNot(HaveOccurred())
+
+		data, err := codec.BinaryFromNative(nil, map[string]any{
+			"id":     sdulidavro.ToNative(id1),
+			"parent": sdulidavro.NullToNative(sdulid.NewNullID(id1)),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(HaveLen(16 + 1 + 16))
+
+		native, _, err := codec.NativeFromBinary(data)
+		Expect(err).ToNot(HaveOccurred())
+
+		record, ok := native.(map[string]any)
+		Expect(ok).To(BeTrue())
+		Expect(sdulidavro.FromNative[testID](record["id"])).To(Equal(id1))
+		Expect(sdulidavro.NullFromNative[testID](record["parent"])).To(Equal(sdulid.NewNullID(id1)))
+	})
+
+	It("should decode null", func() {
+		Expect(sdulidavro.NullToNative(sdulid.NullID[testID]{})).To(BeNil())
+		Expect(sdulidavro.NullFromNative[testID](nil)).To(Equal(sdulid.NullID[testID]{}))
+	})
+
+	It("should verify the kind", func() {
+		_, err := sdulidavro.FromNative[otherID](sdulidavro.ToNative(id1))
+		Expect(err).To(MatchError(sdulid.ErrInvalidSuffix))
+
+		_, err = sdulidavro.FromNative[testID]("tst_01JBRQS1J5A085FYY2M7ZXXZ")
+		Expect(err).To(MatchError(sdulidavro.ErrInvalidNative))
+
+		_, err = sdulidavro.NullFromNative[otherID](sdulidavro.NullToNative(sdulid.NewNullID(id1)))
+		Expect(err).To(MatchError(sdulidavro.ErrInvalidNative))
+	})
+})
@@ -0,0 +1,27 @@
+module github.com/advdv/sdulid/sdulidavro
+
+go 1.23.1
+
+require (
+	github.com/advdv/sdulid v0.0.0-00010101000000-000000000000
+	github.com/linkedin/goavro/v2 v2.12.0
+	github.com/onsi/ginkgo/v2 v2.21.0
+	github.com/onsi/gomega v1.35.1
+)
+
+require (
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-task/slim-sprig/v3 v3.0.0 // indirect
+	github.com/golang/snappy v0.0.3 // indirect
+	github.com/google/go-cmp v0.7.0 // indirect
+	github.com/google/pprof v0.0.0-20241029153458-d1b30febd7db // indirect
+	github.com/kr/text v0.2.0 // indirect
+	github.com/oklog/ulid/v2 v2.1.0 // indirect
+	golang.org/x/net v0.30.0 // indirect
+	golang.org/x/sys v0.26.0 // indirect
+	golang.org/x/text v0.20.0 // indirect
+	golang.org/x/tools v0.26.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/advdv/sdulid => ../
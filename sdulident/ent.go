@@ -0,0 +1,46 @@
+// Package sdulident provides the glue for using self-describing ULIDs as ent fields. IDs implement
+// the field.ValueScanner interface, so a bytes field with a custom Go type is all that is needed:
+//
+//	func (Account) Fields() []ent.Field {
+//		return []ent.Field{
+//			field.Bytes("id").
+//				GoType(model.AccountID{}).
+//				SchemaType(sdulident.SchemaType[model.AccountDesc]()).
+//				DefaultFunc(sdulident.DefaultFunc[model.AccountDesc]()).
+//				Immutable(),
+//		}
+//	}
+//
+// The package doesn't depend on ent itself, the maps and functions it returns are plain Go values.
+package sdulident
+
+import "github.com/advdv/sdulid"
+
+// Dialect names as used by ent's dialect package.
+const (
+	DialectPostgres = "postgres"
+	DialectMySQL    = "mysql"
+	DialectSQLite   = "sqlite3"
+)
+
+// SchemaType returns the column types of IDs of kind T per ent dialect. For Postgres it is the domain
+// created by sdulid.CreateDomainSQL, so the domain needs to exist before ent's migration runs.
+func SchemaType[T sdulid.Kind]() map[string]string {
+	var kind T
+
+	return SchemaTypeFor(kind)
+}
+
+// SchemaTypeFor is like SchemaType but for a kind that is only known at runtime.
+func SchemaTypeFor(kind sdulid.Kind) map[string]string {
+	return map[string]string{
+		DialectPostgres: kind.KindIdent() + "_id",
+		DialectMySQL:    "binary(16)",
+		DialectSQLite:   "blob",
+	}
+}
+
+// DefaultFunc returns a function that generates new IDs of kind T, for use as the field's default.
+func DefaultFunc[T sdulid.Kind]() func() sdulid.ID[T] {
+	return func() sdulid.ID[T] { return sdulid.Make[T]() }
+}
@@ -0,0 +1,49 @@
+package sdulident_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"math"
+	"testing"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulident"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSdulident(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulident")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+var _ = Describe("ent", func() {
+	It("should implement ent's value scanner", func() {
+		_, isValuer := any(sdulid.ID[testID]{}).(driver.Valuer)
+		_, isScanner := any(&sdulid.ID[testID]{}).(sql.Scanner)
+		Expect(isValuer).To(BeTrue())
+		Expect(isScanner).To(BeTrue())
+	})
+
+	It("should return the schema type per dialect", func() {
+		Expect(sdulident.SchemaType[testID]()).To(Equal(map[string]string{
+			"postgres": "test_id",
+			"mysql":    "binary(16)",
+			"sqlite3":  "blob",
+		}))
+	})
+
+	It("should generate new ids by default", func() {
+		fn := sdulident.DefaultFunc[testID]()
+		id1, id2 := fn(), fn()
+		Expect(id1.IsZero()).To(BeFalse())
+		Expect(id1).ToNot(Equal(id2))
+	})
+})
@@ -0,0 +1,47 @@
+// Package sdulidfx provides the registry and per-kind makers to applications that use fx for
+// dependency injection, so services can depend on a sdulid.Maker instead of calling sdulid.Make.
+//
+//	fx.New(
+//		sdulidfx.Module(UserDesc{}, OrderDesc{}),
+//		sdulidfx.ProvideMaker[UserDesc](),
+//		sdulidfx.ProvideMaker[OrderDesc](),
+//		fx.Invoke(func(users sdulid.Maker[UserDesc]) { ... }),
+//	)
+//
+// Tests substitute a maker with fx.Replace or fx.Decorate, e.g. with one from the sdulidtest package.
+package sdulidfx
+
+import (
+	"github.com/advdv/sdulid"
+	"go.uber.org/fx"
+)
+
+// MakerParams holds the optional dependencies of the makers provided by ProvideMaker.
+type MakerParams struct {
+	fx.In
+
+	// Clock provides the time of new IDs, when provided.
+	Clock sdulid.Clock `optional:"true"`
+	// Hook is called for every generated ID, when provided.
+	Hook sdulid.Hook `optional:"true"`
+}
+
+// Module returns the "sdulid" module that provides a *sdulid.Registry with the kinds registered.
+func Module(kinds ...sdulid.Kind) fx.Option {
+	return fx.Module("sdulid",
+		fx.Provide(func() (*sdulid.Registry, error) {
+			return sdulid.NewRegistry(kinds...)
+		}),
+	)
+}
+
+// ProvideMaker provides a sdulid.Maker[T] that generates IDs with entropy from crypto/rand. A Clock
+// and Hook are used when they are provided to the application.
+func ProvideMaker[T sdulid.Kind]() fx.Option {
+	return fx.Provide(NewMaker[T])
+}
+
+// NewMaker is the constructor used by ProvideMaker.
+func NewMaker[T sdulid.Kind](p MakerParams) sdulid.Maker[T] {
+	return sdulid.CryptoMaker[T]{Clock: p.Clock, Hook: p.Hook}
+}
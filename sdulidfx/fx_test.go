@@ -0,0 +1,69 @@
+package sdulidfx_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidfx"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/fx"
+)
+
+func TestSdulidfx(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidfx")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+type otherID struct{}
+
+func (otherID) KindNumber() uint16     { return 1 }
+func (otherID) KindIdent() string      { return "other" }
+func (otherID) KindShortIdent() string { return "oth" }
+
+type collidingID struct{}
+
+func (collidingID) KindNumber() uint16     { return 1 }
+func (collidingID) KindIdent() string      { return "colliding" }
+func (collidingID) KindShortIdent() string { return "col" }
+
+type fixedClock struct{}
+
+func (fixedClock) Now() time.Time { return time.UnixMilli(1730628322885) }
+
+var _ = Describe("fx", func() {
+	It("should provide the registry and makers", func() {
+		var (
+			reg    *sdulid.Registry
+			tests  sdulid.Maker[testID]
+			others sdulid.Maker[otherID]
+		)
+
+		app := fx.New(fx.NopLogger,
+			sdulidfx.Module(testID{}, otherID{}),
+			sdulidfx.ProvideMaker[testID](),
+			sdulidfx.ProvideMaker[otherID](),
+			fx.Supply(fx.Annotate(fixedClock{}, fx.As(new(sdulid.Clock)))),
+			fx.Populate(&reg, &tests, &others))
+		Expect(app.Err()).ToNot(HaveOccurred())
+
+		Expect(reg.Kinds()).To(HaveLen(2))
+		Expect(tests.Make().Timestamp()).To(Equal(uint64(1730628322885)))
+		Expect(others.Make().String()).To(HavePrefix("oth_"))
+	})
+
+	It("should fail on colliding kinds", func() {
+		app := fx.New(fx.NopLogger, sdulidfx.Module(otherID{}, collidingID{}),
+			fx.Invoke(func(*sdulid.Registry) {}))
+		Expect(app.Err()).To(MatchError(sdulid.ErrKindCollision))
+	})
+})
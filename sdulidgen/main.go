@@ -2,49 +2,55 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"text/template"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidmanifest"
 )
 
 // Entity represents an entity with a name, short identifier, and kind number.
-type Entity struct {
-	Name       string
-	ShortIdent string
-	KindNumber int
-}
+type Entity = sdulidmanifest.Entity
 
-const (
-	expectedParts = 3 // Expected number of parts in each argument (Name, ShortIdent, KindNumber)
-)
+// Manifest describes the entities for which code is generated.
+type Manifest = sdulidmanifest.Manifest
+
+const expectedParts = 3 // Expected number of parts in each argument (Name, ShortIdent, KindNumber)
 
-const tmpl = `// Code generated by generate_kinds.go; DO NOT EDIT.
+const tmpl = `// Code generated by sdulidgen; DO NOT EDIT.
 
-package model
+package {{ .Package }}
 
 import "github.com/advdv/sdulid"
 
-{{ range . }}
+{{ range .Entities }}
 // {{ .Name }}Desc entity.
 type {{ .Name }}Desc struct{}
 
 // KindNumber implementation.
-func ({{ .Name }}Desc) KindNumber() uint16 { return {{ .KindNumber }} }
+func ({{ .Name }}Desc) KindNumber() uint16 { return {{ .Number }} }
 
 // KindIdent implementation.
-func ({{ .Name }}Desc) KindIdent() string { return "{{ .Name | toLower }}" }
+func ({{ .Name }}Desc) KindIdent() string { return "{{ .Ident }}" }
 
 // KindShortIdent implementation.
 func ({{ .Name }}Desc) KindShortIdent() string { return "{{ .ShortIdent }}" }
 
 {{ end }}
 
-{{ range . }}
+{{ range .Entities }}
 // {{ .Name }}ID is a type alias for sdulid.ID[{{ .Name }}Desc].
 type {{ .Name }}ID = sdulid.ID[{{ .Name }}Desc]
 
+// Null{{ .Name }}ID is a type alias for sdulid.NullID[{{ .Name }}Desc], for nullable columns.
+type Null{{ .Name }}ID = sdulid.NullID[{{ .Name }}Desc]
+
 // Make{{ .Name }}ID creates a new {{ .Name }}ID.
 func Make{{ .Name }}ID() {{ .Name }}ID { return sdulid.Make[{{ .Name }}Desc]() }
 
@@ -56,10 +62,73 @@ func {{ .Name }}IDFromULID(s string) ({{ .Name }}ID, error) { return sdulid.From
 {{ end }}
 `
 
+const registryTmpl = `// Code generated by sdulidgen; DO NOT EDIT.
+
+package {{ .Package }}
+
+import "github.com/advdv/sdulid"
+
+// Registry holds all generated kinds so IDs can be parsed without knowing their kind statically.
+var Registry = sdulid.MustNewRegistry({{ range $i, $e := .Entities }}{{ if $i }}, {{ end }}{{ $e.Name }}Desc{}{{ end }})
+`
+
+const gqlgenTmpl = `# Code generated by sdulidgen; DO NOT EDIT.
+# Merge the models below into gqlgen.yml and declare the scalars in your schema:
+#{{ range .Entities }}
+#   scalar {{ .Name }}ID{{ end }}
+
+models:{{ range .Entities }}
+  {{ .Name }}ID:
+    model: {{ $.ImportPath }}.{{ .Name }}ID{{ end }}
+`
+
+const sqlcTmpl = `# Code generated by sdulidgen; DO NOT EDIT.
+# Merge the overrides below into the "gen.go" section of sqlc.yaml, columns must use the domains from kinds.sql.
+
+overrides:{{ range .Entities }}
+  - db_type: {{ .Ident }}_id
+    go_type:
+      import: {{ $.ImportPath }}
+      package: {{ $.Package }}
+      type: {{ .Name }}ID
+  - db_type: {{ .Ident }}_id
+    nullable: true
+    go_type:
+      import: {{ $.ImportPath }}
+      package: {{ $.Package }}
+      type: Null{{ .Name }}ID{{ end }}
+`
+
+const tsTmpl = `// Code generated by sdulidgen; DO NOT EDIT.
+{{ range .Entities }}
+/** {{ .Name }}Id is a self-describing ULID of the {{ .Ident }} kind. */
+export type {{ .Name }}Id = string & { readonly __kind: '{{ .ShortIdent }}' };
+{{ end }}
+/** prefixes maps each ID type to the short ident it is prefixed with. */
+export const prefixes = {
+{{- range .Entities }}
+  {{ .Name }}Id: '{{ .ShortIdent }}',
+{{- end }}
+} as const;
+{{ range .Entities }}
+/** is{{ .Name }}Id checks whether s is a {{ .Name }}Id in the prefixed text form. */
+export function is{{ .Name }}Id(s: string): s is {{ .Name }}Id {
+  return /^{{ .ShortIdent }}_[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{23}$/.test(s);
+}
+
+/** parse{{ .Name }}Id returns s as a {{ .Name }}Id, it throws if s is not in the prefixed text form. */
+export function parse{{ .Name }}Id(s: string): {{ .Name }}Id {
+  if (!is{{ .Name }}Id(s)) {
+    throw new Error(` + "`invalid {{ .Name }}Id: ${s}`" + `);
+  }
+
+  return s;
+}
+{{ end }}`
+
 func parseArgs(args []string) ([]Entity, error) {
 	// Pre-allocate based on number of args
 	entities := make([]Entity, 0, len(args))
-	kindNumberSet := make(map[int]bool)
 
 	for _, arg := range args {
 		parts := strings.Split(arg, ":")
@@ -74,19 +143,13 @@ func parseArgs(args []string) ([]Entity, error) {
 			return nil, fmt.Errorf("invalid kind number for %s: %w", name, err)
 		}
 
-		// Check for duplicate KindNumber
-		if kindNumberSet[kindNumber] {
-			return nil, fmt.Errorf("duplicate KindNumber %d for entity %s", kindNumber, name)
-		}
-		kindNumberSet[kindNumber] = true
-
-		entities = append(entities, Entity{Name: name, ShortIdent: shortIdent, KindNumber: kindNumber})
+		entities = append(entities, Entity{Name: name, ShortIdent: shortIdent, Number: kindNumber})
 	}
 
-	return entities, nil
+	return sdulidmanifest.Check(entities)
 }
 
-func generateFile(outputFileName string, entities []Entity) error {
+func generateFile(outputFileName, text string, manifest *Manifest) error {
 	// Create the output file
 	f, err := os.Create(outputFileName)
 	if err != nil {
@@ -95,35 +158,127 @@ func generateFile(outputFileName string, entities []Entity) error {
 	defer f.Close()
 
 	// Parse the template
-	t := template.Must(template.New("kinds").Funcs(template.FuncMap{
-		"toLower": strings.ToLower,
-	}).Parse(tmpl))
+	t := template.Must(template.New("kinds").Parse(text))
 
 	// Execute the template and write to the file
-	if err := t.Execute(f, entities); err != nil {
+	if err := t.Execute(f, manifest); err != nil {
 		return fmt.Errorf("error executing template: %w", err)
 	}
 
 	return nil
 }
 
+// generateSQL renders the domain and generator DDL for all entities.
+func generateSQL(manifest *Manifest) string {
+	var b strings.Builder
+	b.WriteString("-- Code generated by sdulidgen; DO NOT EDIT.\n")
+
+	for _, entity := range manifest.Entities {
+		fmt.Fprintf(&b, "\n-- %s\n%s;\n\n%s\n", entity.Name,
+			strings.TrimSpace(sdulid.CreateDomainSQLFor(entity.Kind())),
+			sdulid.CreateGeneratorSQLFor(entity.Kind()))
+	}
+
+	return b.String()
+}
+
+// outputs configures the optional outputs of generateManifest.
+type outputs struct {
+	// GQLGen generates the gqlgen model bindings for the ID scalars.
+	GQLGen bool
+	// TypeScript generates branded TypeScript types with validation functions.
+	TypeScript bool
+	// SQLC generates the sqlc type overrides for the domains.
+	SQLC bool
+}
+
+// generateManifest generates the kinds, the registry and the SQL DDL from a manifest file. Optional
+// outputs are generated as configured.
+func generateManifest(manifestFile, outDir string, opts outputs) error {
+	manifest, err := sdulidmanifest.ReadFile(manifestFile)
+	if err != nil {
+		return err
+	}
+
+	if err := generateFile(filepath.Join(outDir, "kinds.go"), tmpl, manifest); err != nil {
+		return err
+	}
+
+	if err := generateFile(filepath.Join(outDir, "registry.go"), registryTmpl, manifest); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(outDir, "kinds.sql"), []byte(generateSQL(manifest)), 0o600); err != nil {
+		return fmt.Errorf("error writing sql: %w", err)
+	}
+
+	if opts.GQLGen {
+		if manifest.ImportPath == "" {
+			return errors.New("manifest must specify the import_path to generate gqlgen bindings")
+		}
+
+		if err := generateFile(filepath.Join(outDir, "gqlgen.sdulid.yml"), gqlgenTmpl, manifest); err != nil {
+			return err
+		}
+	}
+
+	if opts.SQLC {
+		if manifest.ImportPath == "" {
+			return errors.New("manifest must specify the import_path to generate sqlc overrides")
+		}
+
+		if err := generateFile(filepath.Join(outDir, "sqlc.sdulid.yaml"), sqlcTmpl, manifest); err != nil {
+			return err
+		}
+	}
+
+	if opts.TypeScript {
+		if err := generateFile(filepath.Join(outDir, "kinds.ts"), tsTmpl, manifest); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func main() {
-	if len(os.Args) < 3 { //nolint:mnd
-		fmt.Fprintln(os.Stderr, "Usage: go run generate_kinds.go <output_file> <Name:ShortIdent:KindNumber>...")
+	manifestFile := flag.String("manifest", "", "YAML (or JSON) manifest describing the entities")
+	gqlgen := flag.Bool("gqlgen", false, "also write gqlgen.sdulid.yml with gqlgen model bindings when using -manifest")
+	sqlc := flag.Bool("sqlc", false, "also write sqlc.sdulid.yaml with sqlc type overrides when using -manifest")
+	ts := flag.Bool("ts", false, "also write kinds.ts with branded TypeScript types when using -manifest")
+	outDir := flag.String("out", ".", "directory to write kinds.go, registry.go and kinds.sql to when using -manifest")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: sdulidgen -manifest <file> [-out <dir>] [-gqlgen] [-sqlc] [-ts]")
+		fmt.Fprintln(os.Stderr, "       sdulidgen <output_file> <Name:ShortIdent:KindNumber>...")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *manifestFile != "" {
+		if err := generateManifest(*manifestFile, *outDir, outputs{GQLGen: *gqlgen, SQLC: *sqlc, TypeScript: *ts}); err != nil {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	if flag.NArg() < 2 { //nolint:mnd
+		flag.Usage()
 		os.Exit(1)
 	}
 
 	// Get the output file name from the first argument
-	outputFileName := os.Args[1]
+	outputFileName := flag.Arg(0)
 	// Parse the entity definitions from remaining arguments
-	entities, err := parseArgs(os.Args[2:])
+	entities, err := parseArgs(flag.Args()[1:])
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "Error:", err)
 		os.Exit(1)
 	}
 
 	// Generate the file
-	if err := generateFile(outputFileName, entities); err != nil {
+	if err := generateFile(outputFileName, tmpl, &Manifest{Package: sdulidmanifest.DefaultPackage, Entities: entities}); err != nil {
 		fmt.Fprintln(os.Stderr, "Error:", err)
 		os.Exit(1)
 	}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSdulidgen(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidgen")
+}
+
+var _ = Describe("manifest", func() {
+	It("should generate kinds, registry and sql", func() {
+		dir := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "kinds.yaml"), []byte(`
+package: foo
+entities:
+  - {name: Account, short_ident: acc, number: 1}
+  - {name: Order, short_ident: ord, number: 2}
+`), 0o600)).To(Succeed())
+
+		Expect(generateManifest(filepath.Join(dir, "kinds.yaml"), dir, outputs{})).To(Succeed())
+
+		kinds, err := os.ReadFile(filepath.Join(dir, "kinds.go"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(kinds)).To(ContainSubstring("package foo"))
+		Expect(string(kinds)).To(ContainSubstring(`func (OrderDesc) KindShortIdent() string { return "ord" }`))
+
+		registry, err := os.ReadFile(filepath.Join(dir, "registry.go"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(registry)).To(ContainSubstring(`sdulid.MustNewRegistry(AccountDesc{}, OrderDesc{})`))
+
+		sql, err := os.ReadFile(filepath.Join(dir, "kinds.sql"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(sql)).To(ContainSubstring("CREATE DOMAIN account_id AS bytea"))
+		Expect(string(sql)).To(ContainSubstring("CREATE FUNCTION generate_order_id()"))
+
+		Expect(filepath.Join(dir, "gqlgen.sdulid.yml")).ToNot(BeAnExistingFile())
+	})
+
+	It("should generate gqlgen bindings", func() {
+		dir := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "kinds.yaml"), []byte(`
+import_path: example.com/foo
+entities:
+  - {name: Account, short_ident: acc, number: 1}
+`), 0o600)).To(Succeed())
+
+		Expect(generateManifest(filepath.Join(dir, "kinds.yaml"), dir, outputs{GQLGen: true})).To(Succeed())
+
+		gql, err := os.ReadFile(filepath.Join(dir, "gqlgen.sdulid.yml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(gql)).To(ContainSubstring("#   scalar AccountID"))
+		Expect(string(gql)).To(ContainSubstring("  AccountID:\n    model: example.com/foo.AccountID"))
+	})
+
+	It("should generate sqlc overrides", func() {
+		dir := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "kinds.yaml"), []byte(`
+package: foo
+import_path: example.com/foo
+entities:
+  - {name: Account, short_ident: acc, number: 1}
+`), 0o600)).To(Succeed())
+
+		Expect(generateManifest(filepath.Join(dir, "kinds.yaml"), dir, outputs{SQLC: true})).To(Succeed())
+
+		kinds, err := os.ReadFile(filepath.Join(dir, "kinds.go"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(kinds)).To(ContainSubstring("type NullAccountID = sdulid.NullID[AccountDesc]"))
+
+		sqlc, err := os.ReadFile(filepath.Join(dir, "sqlc.sdulid.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(sqlc)).To(ContainSubstring(`
+  - db_type: account_id
+    go_type:
+      import: example.com/foo
+      package: foo
+      type: AccountID
+  - db_type: account_id
+    nullable: true
+    go_type:
+      import: example.com/foo
+      package: foo
+      type: NullAccountID
+`))
+	})
+
+	It("should generate typescript", func() {
+		dir := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "kinds.yaml"), []byte(`
+entities:
+  - {name: Account, short_ident: acc, number: 1}
+  - {name: Order, short_ident: ord, number: 2}
+`), 0o600)).To(Succeed())
+
+		Expect(generateManifest(filepath.Join(dir, "kinds.yaml"), dir, outputs{TypeScript: true})).To(Succeed())
+
+		ts, err := os.ReadFile(filepath.Join(dir, "kinds.ts"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(ts)).To(ContainSubstring(`export type AccountId = string & { readonly __kind: 'acc' };`))
+		Expect(string(ts)).To(ContainSubstring("export const prefixes = {\n  AccountId: 'acc',\n  OrderId: 'ord',\n} as const;"))
+		Expect(string(ts)).To(ContainSubstring(`return /^ord_[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{23}$/.test(s);`))
+		Expect(string(ts)).To(ContainSubstring("throw new Error(`invalid OrderId: ${s}`);"))
+	})
+
+	It("should require import path for gqlgen bindings", func() {
+		dir := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "kinds.yaml"), []byte(`
+entities:
+  - {name: Account, short_ident: acc, number: 1}
+`), 0o600)).To(Succeed())
+
+		Expect(generateManifest(filepath.Join(dir, "kinds.yaml"), dir, outputs{GQLGen: true})).To(MatchError(ContainSubstring("import_path")))
+		Expect(generateManifest(filepath.Join(dir, "kinds.yaml"), dir, outputs{SQLC: true})).To(MatchError(ContainSubstring("import_path")))
+	})
+})
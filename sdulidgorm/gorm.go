@@ -0,0 +1,94 @@
+// Package sdulidgorm provides GORM integration for self-describing ULIDs.
+//
+// IDs implement sql.Scanner and driver.Valuer so GORM stores them as 16 raw bytes without any help,
+// declare the column type with a tag such as `gorm:"type:account_id"` to use the domain from
+// sdulid.CreateDomainSQL. The serializer in this package is for tables that store IDs as text.
+package sdulidgorm
+
+import (
+	"context"
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm/schema"
+)
+
+// TextSerializerName is the name the TextSerializer is registered under, use it in the field tag:
+// `gorm:"serializer:sdulidtext"`.
+const TextSerializerName = "sdulidtext"
+
+var (
+	// ErrNotText is returned when a field that uses the TextSerializer can't be (un)marshaled as text.
+	ErrNotText = errors.New("sdulidgorm: field type does not implement text marshaling")
+	// ErrScanValue is returned when the database value is neither a string nor a byte slice.
+	ErrScanValue = errors.New("sdulidgorm: unsupported database value")
+)
+
+// Register registers the serializers of this package with GORM. It should be called once, before
+// any model that uses them is parsed.
+func Register() {
+	schema.RegisterSerializer(TextSerializerName, TextSerializer{})
+}
+
+// TextSerializer stores IDs (or anything else that implements encoding.TextMarshaler) in their
+// prefixed text form, and parses them back when reading. The kind is checked on every read.
+type TextSerializer struct{}
+
+// Scan implements the schema.SerializerInterface.
+func (TextSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue any) error {
+	fieldValue := reflect.New(field.FieldType)
+
+	if dbValue != nil {
+		var text []byte
+
+		switch v := dbValue.(type) {
+		case string:
+			text = []byte(v)
+		case []byte:
+			text = v
+		default:
+			return fmt.Errorf("%w: %T", ErrScanValue, dbValue)
+		}
+
+		// for pointer fields, allocate the value that is pointed to and unmarshal into that.
+		target := fieldValue
+		if field.FieldType.Kind() == reflect.Pointer {
+			target = reflect.New(field.FieldType.Elem())
+			fieldValue.Elem().Set(target)
+		}
+
+		unm, ok := target.Interface().(encoding.TextUnmarshaler)
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrNotText, field.FieldType)
+		}
+
+		if err := unm.UnmarshalText(text); err != nil {
+			return fmt.Errorf("failed to scan field %s: %w", field.Name, err)
+		}
+	}
+
+	field.ReflectValueOf(ctx, dst).Set(fieldValue.Elem())
+
+	return nil
+}
+
+// Value implements the schema.SerializerValuerInterface.
+func (TextSerializer) Value(_ context.Context, field *schema.Field, _ reflect.Value, fieldValue any) (any, error) {
+	if rv := reflect.ValueOf(fieldValue); !rv.IsValid() || (rv.Kind() == reflect.Pointer && rv.IsNil()) {
+		return nil, nil
+	}
+
+	m, ok := fieldValue.(encoding.TextMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotText, field.FieldType)
+	}
+
+	text, err := m.MarshalText()
+	if err != nil {
+		return nil, fmt.Errorf("failed to value field %s: %w", field.Name, err)
+	}
+
+	return string(text), nil
+}
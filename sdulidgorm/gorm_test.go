@@ -0,0 +1,100 @@
+package sdulidgorm_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"math"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidgorm"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm/schema"
+)
+
+func TestSdulidgorm(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidgorm")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+type otherID struct{}
+
+func (otherID) KindNumber() uint16     { return 1 }
+func (otherID) KindIdent() string      { return "other" }
+func (otherID) KindShortIdent() string { return "oth" }
+
+type model struct {
+	ID      sdulid.ID[testID]   `gorm:"type:test_id"`
+	OwnerID sdulid.ID[otherID]  `gorm:"serializer:sdulidtext"`
+	PrevID  *sdulid.ID[otherID] `gorm:"serializer:sdulidtext"`
+}
+
+var _ = Describe("gorm", func() {
+	var sch *schema.Schema
+	var ctx context.Context
+
+	BeforeEach(func() {
+		sdulidgorm.Register()
+
+		var err error
+		sch, err = schema.Parse(&model{}, &sync.Map{}, schema.NamingStrategy{})
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx = context.Background()
+	})
+
+	It("should store the id as bytes without a serializer", func() {
+		Expect(sch.LookUpField("ID").DataType).To(Equal(schema.DataType("test_id")))
+	})
+
+	It("should value and scan as text", func() {
+		owner := sdulid.MustFromULID[otherID]("01JBRQS1J5A085FYY2M7ZXW001")
+		m := &model{OwnerID: owner}
+		rv := reflect.ValueOf(m)
+
+		field := sch.LookUpField("OwnerID")
+		val, _ := field.ValueOf(ctx, rv)
+		text, err := val.(driver.Valuer).Value()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(text).To(Equal("oth_01JBRQS1J5A085FYY2M7ZXW0"))
+
+		var scanned model
+		Expect(field.Serializer.Scan(ctx, field, reflect.ValueOf(&scanned), []byte("oth_01JBRQS1J5A085FYY2M7ZXW0"))).To(Succeed())
+		Expect(scanned.OwnerID).To(Equal(owner))
+	})
+
+	It("should handle nil pointers and NULL", func() {
+		m := &model{}
+		rv := reflect.ValueOf(m)
+
+		field := sch.LookUpField("PrevID")
+		val, _ := field.ValueOf(ctx, rv)
+		text, err := val.(driver.Valuer).Value()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(text).To(BeNil())
+
+		Expect(field.Serializer.Scan(ctx, field, rv, "oth_01JBRQS1J5A085FYY2M7ZXW0")).To(Succeed())
+		Expect(m.PrevID).ToNot(BeNil())
+		Expect(field.Serializer.Scan(ctx, field, rv, nil)).To(Succeed())
+		Expect(m.PrevID).To(BeNil())
+	})
+
+	It("should check the kind on scan", func() {
+		var m model
+
+		field := sch.LookUpField("OwnerID")
+		Expect(field.Serializer.Scan(ctx, field, reflect.ValueOf(&m), "tst_01JBRQS1J5A085FYY2M7ZXXZ")).To(
+			MatchError(sdulid.ErrWrongKind))
+		Expect(field.Serializer.Scan(ctx, field, reflect.ValueOf(&m), 42)).To(MatchError(sdulidgorm.ErrScanValue))
+	})
+})
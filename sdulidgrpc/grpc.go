@@ -0,0 +1,195 @@
+// Package sdulidgrpc provides gRPC server interceptors that validate the IDs in incoming messages before
+// handlers run.
+//
+// Fields are validated by naming convention: a string field named after the ident of a registered kind
+// with an "_id" suffix (e.g. "account_id", or "owner_account_id"), must hold an ID of that kind in one of
+// the text forms. Repeated fields use the "_ids" suffix. Empty values are not validated, use required
+// field checks for that. Nested messages are validated recursively.
+package sdulidgrpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/advdv/sdulid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldError describes an ID field that failed validation.
+type FieldError struct {
+	// Field is the path of the field in the message, e.g: "order.account_id".
+	Field string
+	// Kind is the ident of the kind the field should hold.
+	Kind string
+	// Err is the reason the value was rejected.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("sdulidgrpc: invalid %s id in field %q: %v", e.Kind, e.Field, e.Err)
+}
+
+// Unwrap returns the reason the value was rejected.
+func (e *FieldError) Unwrap() error { return e.Err }
+
+// Validate checks the ID fields of msg, the kinds are looked up in reg. It returns a *FieldError for the
+// first field that fails validation.
+func Validate(reg *sdulid.Registry, msg proto.Message) error {
+	return validateMessage(reg, msg.ProtoReflect(), "")
+}
+
+// UnaryServerInterceptor returns an interceptor that validates the ID fields of requests, invalid
+// requests are rejected with codes.InvalidArgument.
+func UnaryServerInterceptor(reg *sdulid.Registry) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := validateRequest(reg, req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor that validates the ID fields of every received message,
+// invalid messages are rejected with codes.InvalidArgument.
+func StreamServerInterceptor(reg *sdulid.Registry) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, reg: reg})
+	}
+}
+
+// serverStream validates messages as they are received.
+type serverStream struct {
+	grpc.ServerStream
+	reg *sdulid.Registry
+}
+
+func (s *serverStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return validateRequest(s.reg, m)
+}
+
+// validateRequest validates req if it is a proto message, and turns a failure into a gRPC status.
+func validateRequest(reg *sdulid.Registry, req any) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	if err := Validate(reg, msg); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error()) //nolint:wrapcheck
+	}
+
+	return nil
+}
+
+func validateMessage(reg *sdulid.Registry, msg protoreflect.Message, path string) error {
+	var err error
+
+	msg.Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		err = validateField(reg, fd, val, path+string(fd.Name()))
+
+		return err == nil
+	})
+
+	return err
+}
+
+func validateField(reg *sdulid.Registry, fd protoreflect.FieldDescriptor, val protoreflect.Value, path string) error {
+	switch {
+	case fd.IsMap():
+		if fd.MapValue().Kind() != protoreflect.MessageKind {
+			return nil
+		}
+
+		var err error
+
+		val.Map().Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+			err = validateMessage(reg, val.Message(), fmt.Sprintf("%s[%v].", path, key.Interface()))
+
+			return err == nil
+		})
+
+		return err
+	case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+		if !fd.IsList() {
+			return validateMessage(reg, val.Message(), path+".")
+		}
+
+		for i := range val.List().Len() {
+			if err := validateMessage(reg, val.List().Get(i).Message(), fmt.Sprintf("%s[%d].", path, i)); err != nil {
+				return err
+			}
+		}
+	case fd.Kind() == protoreflect.StringKind:
+		kind, ok := fieldKind(reg, string(fd.Name()), fd.IsList())
+		if !ok {
+			return nil
+		}
+
+		if !fd.IsList() {
+			return validateID(reg, kind, val.String(), path)
+		}
+
+		for i := range val.List().Len() {
+			if err := validateID(reg, kind, val.List().Get(i).String(), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// fieldKind returns the kind that a field should hold according to its name.
+func fieldKind(reg *sdulid.Registry, name string, list bool) (sdulid.Kind, bool) {
+	suffix := "_id"
+	if list {
+		suffix = "_ids"
+	}
+
+	stem, ok := strings.CutSuffix(name, suffix)
+	if !ok {
+		return nil, false
+	}
+
+	// try the longest ident first so "owner_account_id" resolves to "owner_account" before "account".
+	for {
+		if kind, ok := reg.LookupIdent(stem); ok {
+			return kind, true
+		}
+
+		_, rest, found := strings.Cut(stem, "_")
+		if !found {
+			return nil, false
+		}
+
+		stem = rest
+	}
+}
+
+func validateID(reg *sdulid.Registry, kind sdulid.Kind, s, path string) error {
+	if s == "" {
+		return nil
+	}
+
+	_, actual, err := reg.ParseAny(s)
+	if err == nil && actual.KindNumber() != kind.KindNumber() {
+		err = &sdulid.WrongKindError{Expected: kind.KindShortIdent(), Actual: actual.KindShortIdent()}
+	}
+
+	if err != nil {
+		return &FieldError{Field: path, Kind: kind.KindIdent(), Err: err}
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package sdulidgrpc_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidgrpc"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestSdulidgrpc(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidgrpc")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+type otherID struct{}
+
+func (otherID) KindNumber() uint16     { return 1 }
+func (otherID) KindIdent() string      { return "other" }
+func (otherID) KindShortIdent() string { return "oth" }
+
+const (
+	testIDStr  = "tst_01JBRQS1J5A085FYY2M7ZXXZ"
+	otherIDStr = "oth_01JBRQS1J5A085FYY2M7ZXW0"
+)
+
+// requestDesc describes a message with ID fields in all the places the convention covers.
+var requestDesc = func() protoreflect.MessageDescriptor {
+	field := func(name string, num int32, typ descriptorpb.FieldDescriptorProto_Type,
+		label descriptorpb.FieldDescriptorProto_Label, typeName string,
+	) *descriptorpb.FieldDescriptorProto {
+		fd := &descriptorpb.FieldDescriptorProto{
+			Name: proto.String(name), Number: proto.Int32(num), Type: typ.Enum(), Label: label.Enum(),
+		}
+		if typeName != "" {
+			fd.TypeName = proto.String(typeName)
+		}
+
+		return fd
+	}
+
+	str, msg := descriptorpb.FieldDescriptorProto_TYPE_STRING, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	opt, rep := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("sdulidgrpc_test.proto"),
+		Package: proto.String("sdulidgrpc.test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: proto.String("Child"), Field: []*descriptorpb.FieldDescriptorProto{
+				field("test_id", 1, str, opt, ""),
+			}},
+			{Name: proto.String("Request"), Field: []*descriptorpb.FieldDescriptorProto{
+				field("test_id", 1, str, opt, ""),
+				field("other_ids", 2, str, rep, ""),
+				field("external_id", 3, str, opt, ""),
+				field("owner_test_id", 4, str, opt, ""),
+				field("child", 5, msg, opt, ".sdulidgrpc.test.Child"),
+				field("children", 6, msg, rep, ".sdulidgrpc.test.Child"),
+				field("by_name", 7, msg, rep, ".sdulidgrpc.test.Request.ByNameEntry"),
+			}, NestedType: []*descriptorpb.DescriptorProto{
+				{
+					Name: proto.String("ByNameEntry"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						field("key", 1, str, opt, ""),
+						field("value", 2, msg, opt, ".sdulidgrpc.test.Child"),
+					},
+					Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+				},
+			}},
+		},
+	}
+
+	fd, err := protodesc.NewFile(fdp, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return fd.Messages().ByName("Request")
+}()
+
+// newRequest builds a request message and lets mod set its fields.
+func newRequest(mod func(m *dynamicpb.Message)) *dynamicpb.Message {
+	m := dynamicpb.NewMessage(requestDesc)
+	if mod != nil {
+		mod(m)
+	}
+
+	return m
+}
+
+func set(m protoreflect.Message, name string, v any) {
+	m.Set(m.Descriptor().Fields().ByName(protoreflect.Name(name)), protoreflect.ValueOf(v))
+}
+
+func child(testID string) protoreflect.Message {
+	c := dynamicpb.NewMessage(requestDesc.Fields().ByName("child").Message())
+	set(c, "test_id", testID)
+
+	return c
+}
+
+var _ = Describe("validate", func() {
+	reg := sdulid.MustNewRegistry(testID{}, otherID{})
+
+	DescribeTable("messages",
+		func(mod func(m *dynamicpb.Message), expField string) {
+			err := sdulidgrpc.Validate(reg, newRequest(mod))
+			if expField == "" {
+				Expect(err).ToNot(HaveOccurred())
+
+				return
+			}
+
+			var ferr *sdulidgrpc.FieldError
+			Expect(err).To(BeAssignableToTypeOf(ferr))
+			ferr, _ = err.(*sdulidgrpc.FieldError)
+			Expect(ferr.Field).To(Equal(expField))
+		},
+		Entry("empty", nil, ""),
+		Entry("valid", func(m *dynamicpb.Message) {
+			set(m, "test_id", testIDStr)
+			set(m, "external_id", "not an id")
+			set(m, "owner_test_id", testIDStr)
+			set(m, "child", child(testIDStr))
+			m.Mutable(requestDesc.Fields().ByName("other_ids")).List().Append(protoreflect.ValueOf(otherIDStr))
+		}, ""),
+		Entry("wrong kind", func(m *dynamicpb.Message) {
+			set(m, "test_id", otherIDStr)
+		}, "test_id"),
+		Entry("wrong kind with qualifier", func(m *dynamicpb.Message) {
+			set(m, "owner_test_id", otherIDStr)
+		}, "owner_test_id"),
+		Entry("invalid in list", func(m *dynamicpb.Message) {
+			ids := m.Mutable(requestDesc.Fields().ByName("other_ids")).List()
+			ids.Append(protoreflect.ValueOf(otherIDStr))
+			ids.Append(protoreflect.ValueOf("oth_01JBRQS1J5A085FYY2M7ZXXZ"))
+		}, "other_ids[1]"),
+		Entry("invalid in child", func(m *dynamicpb.Message) {
+			set(m, "child", child(otherIDStr))
+		}, "child.test_id"),
+		Entry("invalid in children", func(m *dynamicpb.Message) {
+			m.Mutable(requestDesc.Fields().ByName("children")).List().Append(protoreflect.ValueOf(child("foo")))
+		}, "children[0].test_id"),
+		Entry("invalid in map", func(m *dynamicpb.Message) {
+			m.Mutable(requestDesc.Fields().ByName("by_name")).Map().Set(
+				protoreflect.ValueOf("a").MapKey(), protoreflect.ValueOf(child(otherIDStr)))
+		}, "by_name[a].test_id"),
+	)
+
+	It("should report the reason", func() {
+		err := sdulidgrpc.Validate(reg, newRequest(func(m *dynamicpb.Message) { set(m, "test_id", otherIDStr) }))
+		Expect(err).To(MatchError(sdulid.ErrWrongKind))
+		Expect(err).To(MatchError(ContainSubstring(`sdulidgrpc: invalid test id in field "test_id"`)))
+	})
+})
+
+// recvStream is a server stream that receives a single message.
+type recvStream struct {
+	grpc.ServerStream
+	msg proto.Message
+}
+
+func (s recvStream) Context() context.Context { return context.Background() }
+
+func (s recvStream) RecvMsg(m any) error {
+	proto.Merge(m.(proto.Message), s.msg) //nolint:forcetypeassert
+
+	return nil
+}
+
+var _ = Describe("interceptors", func() {
+	reg := sdulid.MustNewRegistry(testID{}, otherID{})
+	valid := newRequest(func(m *dynamicpb.Message) { set(m, "test_id", testIDStr) })
+	invalid := newRequest(func(m *dynamicpb.Message) { set(m, "test_id", otherIDStr) })
+
+	DescribeTable("unary",
+		func(req proto.Message, expCode codes.Code) {
+			var called bool
+			_, err := sdulidgrpc.UnaryServerInterceptor(reg)(context.Background(), req, &grpc.UnaryServerInfo{},
+				func(context.Context, any) (any, error) {
+					called = true
+
+					return nil, nil //nolint:nilnil
+				})
+			Expect(status.Code(err)).To(Equal(expCode))
+			Expect(called).To(Equal(expCode == codes.OK))
+		},
+		Entry("valid", valid, codes.OK),
+		Entry("invalid", invalid, codes.InvalidArgument),
+	)
+
+	DescribeTable("stream",
+		func(req proto.Message, expCode codes.Code) {
+			err := sdulidgrpc.StreamServerInterceptor(reg)(nil, recvStream{msg: req}, &grpc.StreamServerInfo{},
+				func(_ any, ss grpc.ServerStream) error {
+					return ss.RecvMsg(dynamicpb.NewMessage(requestDesc))
+				})
+			Expect(status.Code(err)).To(Equal(expCode))
+		},
+		Entry("valid", valid, codes.OK),
+		Entry("invalid", invalid, codes.InvalidArgument),
+	)
+})
@@ -0,0 +1,84 @@
+// Package sdulidhttp provides helpers for parsing self-describing ULIDs from HTTP requests.
+//
+// PathParam reads path values as populated by the net/http ServeMux, and by routers such as chi that
+// set them too. For gin and echo, use Param as the field type of the struct that URL params are bound
+// to: both frameworks call its UnmarshalParam method.
+package sdulidhttp
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/advdv/sdulid"
+)
+
+// ErrMissingParam is returned when the request has no value for the path parameter.
+var ErrMissingParam = errors.New("sdulidhttp: missing path parameter")
+
+// ParamError is returned when a URL parameter doesn't hold a valid ID of the expected kind. It carries
+// enough information to render a 400 response.
+type ParamError struct {
+	// Param is the name of the parameter, it is empty when the parameter is bound by a framework.
+	Param string `json:"param,omitempty"`
+	// Value is the value of the parameter as it was received.
+	Value string `json:"value"`
+	// Kind is the ident of the expected kind.
+	Kind string `json:"kind"`
+	// Err is the reason the value was rejected.
+	Err error `json:"-"`
+}
+
+// Error implements the error interface.
+func (e *ParamError) Error() string {
+	if e.Param == "" {
+		return fmt.Sprintf("sdulidhttp: invalid %s id %q: %v", e.Kind, e.Value, e.Err)
+	}
+
+	return fmt.Sprintf("sdulidhttp: invalid %s id %q for parameter %q: %v", e.Kind, e.Value, e.Param, e.Err)
+}
+
+// Unwrap returns the reason the value was rejected.
+func (e *ParamError) Unwrap() error { return e.Err }
+
+// StatusCode returns the HTTP status code that suits the error: 400 Bad Request.
+func (e *ParamError) StatusCode() int { return http.StatusBadRequest }
+
+// PathParam parses the path value with the given name into an ID of kind T. A *ParamError is returned
+// when the value is missing or is not a valid ID of kind T.
+func PathParam[T sdulid.Kind](r *http.Request, name string) (sdulid.ID[T], error) {
+	var id sdulid.ID[T]
+
+	value := r.PathValue(name)
+	if value == "" {
+		return id, newParamError[T](name, value, ErrMissingParam)
+	}
+
+	if err := id.UnmarshalText([]byte(value)); err != nil {
+		return id, newParamError[T](name, value, err)
+	}
+
+	return id, nil
+}
+
+// Param is an ID that can be bound from URL parameters by gin and echo, which both call UnmarshalParam
+// on fields that implement it.
+type Param[T sdulid.Kind] struct {
+	sdulid.ID[T]
+}
+
+// UnmarshalParam implements the binder interface of gin and echo. It returns a *ParamError when the
+// value is not a valid ID of kind T.
+func (p *Param[T]) UnmarshalParam(value string) error {
+	if err := p.ID.UnmarshalText([]byte(value)); err != nil {
+		return newParamError[T]("", value, err)
+	}
+
+	return nil
+}
+
+func newParamError[T sdulid.Kind](param, value string, err error) *ParamError {
+	var kind T
+
+	return &ParamError{Param: param, Value: value, Kind: kind.KindIdent(), Err: err}
+}
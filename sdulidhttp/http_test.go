@@ -0,0 +1,80 @@
+package sdulidhttp_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidhttp"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSdulidhttp(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidhttp")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+var _ = Describe("path param", func() {
+	var mux *http.ServeMux
+
+	BeforeEach(func() {
+		mux = http.NewServeMux()
+		mux.HandleFunc("GET /tests/{id}", func(w http.ResponseWriter, r *http.Request) {
+			id, err := sdulidhttp.PathParam[testID](r, "id")
+
+			var perr *sdulidhttp.ParamError
+			if errors.As(err, &perr) {
+				http.Error(w, perr.Error(), perr.StatusCode())
+
+				return
+			}
+
+			fmt.Fprint(w, id.String())
+		})
+	})
+
+	DescribeTable("serve",
+		func(path string, expCode int, expBody string) {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+			Expect(rec.Code).To(Equal(expCode))
+			Expect(rec.Body.String()).To(ContainSubstring(expBody))
+		},
+		Entry("valid", "/tests/tst_01JBRQS1J5A085FYY2M7ZXXZ", http.StatusOK, "tst_01JBRQS1J5A085FYY2M7ZXXZ"),
+		Entry("wrong kind", "/tests/oth_01JBRQS1J5A085FYY2M7ZXW0", http.StatusBadRequest,
+			`sdulidhttp: invalid test id "oth_01JBRQS1J5A085FYY2M7ZXW0" for parameter "id"`),
+	)
+
+	It("should return an error for missing params", func() {
+		_, err := sdulidhttp.PathParam[testID](httptest.NewRequest(http.MethodGet, "/", nil), "id")
+		Expect(err).To(MatchError(sdulidhttp.ErrMissingParam))
+	})
+})
+
+var _ = Describe("param", func() {
+	It("should unmarshal params", func() {
+		var p sdulidhttp.Param[testID]
+		Expect(p.UnmarshalParam("tst_01JBRQS1J5A085FYY2M7ZXXZ")).To(Succeed())
+		Expect(p.ID).To(Equal(sdulid.MustParse[testID]("tst_01JBRQS1J5A085FYY2M7ZXXZ")))
+
+		err := p.UnmarshalParam("tst_01JBRQS1J5A085FYY2M7ZXW0")
+		Expect(err).To(MatchError(sdulid.ErrInvalidSuffix))
+
+		var perr *sdulidhttp.ParamError
+		Expect(errors.As(err, &perr)).To(BeTrue())
+		Expect(perr.StatusCode()).To(Equal(http.StatusBadRequest))
+		Expect(perr.Kind).To(Equal("test"))
+	})
+})
@@ -0,0 +1,36 @@
+package sdulidhttp
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/advdv/sdulid"
+)
+
+// RequestIDHeader is the response header that RequestID writes the ID of the request to.
+const RequestIDHeader = "X-Request-Id"
+
+// RequestID returns middleware that mints an ID of the request kind T for every incoming request. The
+// ID is stored in the request's context with sdulid.ContextWithID, and written to the X-Request-Id
+// response header before next is called. IDs are generated by m, when m is nil a
+// sdulid.CryptoMaker is used.
+func RequestID[T sdulid.Kind](m sdulid.Maker[T]) func(next http.Handler) http.Handler {
+	if m == nil {
+		m = sdulid.CryptoMaker[T]{}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := m.Make()
+			w.Header().Set(RequestIDHeader, id.String())
+
+			next.ServeHTTP(w, r.WithContext(sdulid.ContextWithID(r.Context(), id)))
+		})
+	}
+}
+
+// RequestIDFromContext returns the ID of kind T that RequestID stored in ctx, like sdulid.IDFromContext.
+// It returns false when ctx holds no such ID.
+func RequestIDFromContext[T sdulid.Kind](ctx context.Context) (sdulid.ID[T], bool) {
+	return sdulid.IDFromContext[T](ctx)
+}
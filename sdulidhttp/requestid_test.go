@@ -0,0 +1,62 @@
+package sdulidhttp_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidhttp"
+	"github.com/advdv/sdulid/sdulidtest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type requestID struct{}
+
+func (requestID) KindNumber() uint16     { return 1 }
+func (requestID) KindIdent() string      { return "request" }
+func (requestID) KindShortIdent() string { return "req" }
+
+var _ = Describe("request id", func() {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, ok := sdulidhttp.RequestIDFromContext[requestID](r.Context())
+		Expect(ok).To(BeTrue())
+		ctxID, ok := sdulid.IDFromContext[requestID](r.Context())
+		Expect(ok).To(BeTrue())
+		Expect(ctxID).To(Equal(id))
+
+		_, ok = sdulidhttp.RequestIDFromContext[testID](r.Context())
+		Expect(ok).To(BeFalse())
+
+		fmt.Fprint(w, id.String())
+	})
+
+	It("should mint an id per request", func() {
+		srv := sdulidhttp.RequestID[requestID](nil)(handler)
+
+		rec1, rec2 := httptest.NewRecorder(), httptest.NewRecorder()
+		srv.ServeHTTP(rec1, httptest.NewRequest(http.MethodGet, "/", nil))
+		srv.ServeHTTP(rec2, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		Expect(rec1.Header().Get(sdulidhttp.RequestIDHeader)).To(Equal(rec1.Body.String()))
+		Expect(rec1.Body.String()).To(HavePrefix("req_"))
+		Expect(rec1.Body.String()).ToNot(Equal(rec2.Body.String()))
+	})
+
+	It("should use the maker", func() {
+		now := time.UnixMilli(1730628322885)
+		maker, expected := sdulidtest.NewMaker[requestID](0, now), sdulidtest.NewMaker[requestID](0, now)
+
+		rec := httptest.NewRecorder()
+		sdulidhttp.RequestID[requestID](maker)(handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		Expect(rec.Body.String()).To(Equal(expected.Make().String()))
+	})
+
+	It("should not find an id in other contexts", func() {
+		_, ok := sdulidhttp.RequestIDFromContext[requestID](context.Background())
+		Expect(ok).To(BeFalse())
+	})
+})
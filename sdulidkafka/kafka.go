@@ -0,0 +1,88 @@
+// Package sdulidkafka provides Kafka partitioning helpers so all events of one entity land on the same
+// partition deterministically.
+//
+// Use PartitionKey as the record key. With the default Kafka partitioning (murmur2 hashing of the key)
+// the partition follows from the entropy of the ID. For IDs that are generated by a
+// sdulid.ShardedMaker, Partitioner can map the shard bits directly onto partitions instead.
+//
+// The Partitioner implements the franz-go interface. Sarama users can wrap Partition in a type that
+// implements sarama.Partitioner:
+//
+//	func (p partitioner) Partition(msg *sarama.ProducerMessage, n int32) (int32, error) {
+//		key, err := msg.Key.Encode()
+//		return int32(sdulidkafka.Partition(key, int(n), p.shardBits)), err
+//	}
+//	func (partitioner) RequiresConsistency() bool { return true }
+package sdulidkafka
+
+import (
+	"encoding/binary"
+
+	"github.com/advdv/sdulid"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// idSize is the size of the keys that are produced by PartitionKey.
+const idSize = 16
+
+// PartitionKey returns the record key for events of the entity with id: its 16 raw bytes.
+func PartitionKey[T sdulid.Kind](id sdulid.ID[T]) []byte {
+	return id.Bytes()
+}
+
+// Partition returns the partition out of n for a record key. When shardBits is zero, or the key is not
+// an ID, the key is hashed with murmur2 just like Kafka's default partitioner. Otherwise the partition
+// is the shard that is embedded in the ID (see sdulid.ShardOf) modulo n.
+func Partition(key []byte, n int, shardBits uint) int {
+	if shardBits == 0 || shardBits > sdulid.MaxShardBits || len(key) != idSize {
+		return int(murmur2(key)&0x7fffffff) % n //nolint:mnd
+	}
+
+	return int(binary.BigEndian.Uint16(key[6:8])>>(sdulid.MaxShardBits-shardBits)) % n
+}
+
+// Partitioner returns a franz-go partitioner that partitions records with a key using Partition,
+// records without a key are partitioned like the default franz-go partitioner does.
+func Partitioner(shardBits uint) kgo.Partitioner {
+	return kgo.StickyKeyPartitioner(func(key []byte, n int) int {
+		return Partition(key, n, shardBits)
+	})
+}
+
+// murmur2 is the hash function that Kafka uses for partitioning by key.
+func murmur2(b []byte) uint32 {
+	const (
+		seed uint32 = 0x9747b28c
+		m    uint32 = 0x5bd1e995
+		r           = 24
+	)
+
+	h := seed ^ uint32(len(b)) //nolint:gosec
+	for ; len(b) >= 4; b = b[4:] {
+		k := binary.LittleEndian.Uint32(b)
+		k *= m
+		k ^= k >> r
+		k *= m
+
+		h *= m
+		h ^= k
+	}
+
+	switch len(b) {
+	case 3: //nolint:mnd
+		h ^= uint32(b[2]) << 16
+		fallthrough
+	case 2: //nolint:mnd
+		h ^= uint32(b[1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint32(b[0])
+		h *= m
+	}
+
+	h ^= h >> 13
+	h *= m
+	h ^= h >> 15
+
+	return h
+}
@@ -0,0 +1,65 @@
+package sdulidkafka_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidkafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func TestSdulidkafka(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidkafka")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+var _ = Describe("partition", func() {
+	It("should partition like kafka", func() {
+		kafka := kgo.StickyKeyPartitioner(nil).ForTopic("t")
+
+		for range 100 {
+			key := sdulidkafka.PartitionKey(sdulid.Make[testID]())
+			Expect(key).To(HaveLen(16))
+			Expect(sdulidkafka.Partition(key, 12, 0)).To(Equal(kafka.Partition(&kgo.Record{Key: key}, 12)))
+		}
+
+		for _, key := range []string{"", "a", "ab", "abc", "21", "foobar"} {
+			Expect(sdulidkafka.Partition([]byte(key), 7, 4)).To(
+				Equal(kafka.Partition(&kgo.Record{Key: []byte(key)}, 7)), key)
+		}
+	})
+
+	It("should partition by shard", func() {
+		for shard := range uint16(16) {
+			mkr, err := sdulid.NewShardedMaker[testID](4, shard)
+			Expect(err).ToNot(HaveOccurred())
+
+			for range 10 {
+				key := sdulidkafka.PartitionKey(mkr.Make())
+				Expect(sdulidkafka.Partition(key, 16, 4)).To(Equal(int(shard)))
+				Expect(sdulidkafka.Partition(key, 8, 4)).To(Equal(int(shard % 8)))
+			}
+		}
+	})
+
+	It("should keep records of one entity on the same partition", func() {
+		mkr, err := sdulid.NewShardedMaker[testID](4, 5)
+		Expect(err).ToNot(HaveOccurred())
+
+		part := sdulidkafka.Partitioner(4).ForTopic("t")
+		rec := &kgo.Record{Key: sdulidkafka.PartitionKey(mkr.Make())}
+		Expect(part.RequiresConsistency(rec)).To(BeTrue())
+		Expect(part.Partition(rec, 16)).To(Equal(5))
+		Expect(part.Partition(rec, 16)).To(Equal(5))
+	})
+})
@@ -0,0 +1,13 @@
+module github.com/advdv/sdulid/sdulidlint
+
+go 1.23.1
+
+require golang.org/x/tools v0.26.0
+
+require (
+	github.com/google/go-cmp v0.7.0 // indirect
+	golang.org/x/mod v0.21.0 // indirect
+	golang.org/x/sync v0.9.0 // indirect
+)
+
+replace github.com/advdv/sdulid => ../
@@ -0,0 +1,241 @@
+// Package sdulidlint provides an analyzer that finds the Kind implementations in a module and reports
+// duplicate kind numbers and short idents, as well as prefixes and kind numbers that are hard-coded
+// instead of taken from the kind. Run it with go vet:
+//
+//	go install github.com/advdv/sdulid/cmd/sdulidlint@latest
+//	go vet -vettool=$(which sdulidlint) ./...
+//
+// Kinds are compared with the kinds of all packages that a package (transitively) imports, so
+// collisions are found as long as the kinds are used together somewhere, e.g. in a shared registry.
+package sdulidlint
+
+import (
+	"cmp"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// Analyzer reports duplicate and hard-coded kinds.
+var Analyzer = &analysis.Analyzer{
+	Name:      "sdulid",
+	Doc:       "report duplicate kind numbers and short idents, and hard-coded prefixes and kind numbers",
+	URL:       "https://pkg.go.dev/github.com/advdv/sdulid/sdulidlint",
+	Run:       run,
+	FactTypes: []analysis.Fact{new(kindsFact)},
+}
+
+// sdulidPath is the import path of the sdulid package.
+const sdulidPath = "github.com/advdv/sdulid"
+
+// kindDecl describes a Kind implementation of which the methods return constants.
+type kindDecl struct {
+	Type       string
+	Number     int64 // -1 if it isn't constant
+	ShortIdent string
+	Position   string
+	pos        token.Pos
+}
+
+// kindsFact holds the kinds that are declared in a package.
+type kindsFact struct {
+	Kinds []kindDecl
+}
+
+// AFact implements analysis.Fact.
+func (*kindsFact) AFact() {}
+
+func (f *kindsFact) String() string {
+	names := make([]string, 0, len(f.Kinds))
+	for _, kind := range f.Kinds {
+		names = append(names, kind.Type)
+	}
+
+	return "kinds(" + strings.Join(names, ", ") + ")"
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	local := localKinds(pass)
+	if len(local) > 0 {
+		pass.ExportPackageFact(&kindsFact{Kinds: local})
+	}
+
+	var known []kindDecl
+	for _, fact := range pass.AllPackageFacts() {
+		if fact.Package != pass.Pkg {
+			known = append(known, fact.Fact.(*kindsFact).Kinds...) //nolint:forcetypeassert
+		}
+	}
+
+	byNumber, byShort := map[int64]kindDecl{}, map[string]kindDecl{}
+	for _, kind := range known {
+		if _, ok := byNumber[kind.Number]; !ok {
+			byNumber[kind.Number] = kind
+		}
+
+		if _, ok := byShort[kind.ShortIdent]; !ok {
+			byShort[kind.ShortIdent] = kind
+		}
+	}
+
+	for _, kind := range local {
+		if other, ok := byNumber[kind.Number]; ok && kind.Number >= 0 {
+			pass.Reportf(kind.pos, "kind number %d of %s is already used by %s at %s",
+				kind.Number, kind.Type, other.Type, other.Position)
+		} else {
+			byNumber[kind.Number] = kind
+		}
+
+		if other, ok := byShort[kind.ShortIdent]; ok && kind.ShortIdent != "" {
+			pass.Reportf(kind.pos, "short ident %q of %s is already used by %s at %s",
+				kind.ShortIdent, kind.Type, other.Type, other.Position)
+		} else {
+			byShort[kind.ShortIdent] = kind
+		}
+	}
+
+	checkHardCoded(pass, byNumber, byShort)
+
+	return nil, nil //nolint:nilnil
+}
+
+// localKinds returns the kinds that are declared in the package, ordered by position. A kind is any
+// type with a KindNumber or KindShortIdent method that returns a constant.
+func localKinds(pass *analysis.Pass) []kindDecl {
+	byType := map[*types.TypeName]*kindDecl{}
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || (fn.Name.Name != "KindNumber" && fn.Name.Name != "KindShortIdent") {
+				continue
+			}
+
+			value := returnedConstant(pass, fn)
+			if value == nil {
+				continue
+			}
+
+			obj := receiverType(pass, fn)
+			if obj == nil {
+				continue
+			}
+
+			kind, ok := byType[obj]
+			if !ok {
+				kind = &kindDecl{Type: obj.Pkg().Path() + "." + obj.Name(), Number: -1, pos: obj.Pos()}
+				kind.Position = pass.Fset.Position(obj.Pos()).String()
+				byType[obj] = kind
+			}
+
+			switch value.Kind() { //nolint:exhaustive
+			case constant.Int:
+				kind.Number, _ = constant.Int64Val(value)
+			case constant.String:
+				kind.ShortIdent = constant.StringVal(value)
+			}
+		}
+	}
+
+	kinds := make([]kindDecl, 0, len(byType))
+	for _, kind := range byType {
+		kinds = append(kinds, *kind)
+	}
+
+	slices.SortFunc(kinds, func(a, b kindDecl) int { return cmp.Compare(a.pos, b.pos) })
+
+	return kinds
+}
+
+// returnedConstant returns the value of a method that consists of a single return of a constant.
+func returnedConstant(pass *analysis.Pass, fn *ast.FuncDecl) constant.Value {
+	if fn.Body == nil || len(fn.Body.List) != 1 {
+		return nil
+	}
+
+	ret, ok := fn.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return nil
+	}
+
+	return pass.TypesInfo.Types[ret.Results[0]].Value
+}
+
+// receiverType returns the named type of the receiver of fn.
+func receiverType(pass *analysis.Pass, fn *ast.FuncDecl) *types.TypeName {
+	obj, ok := pass.TypesInfo.Defs[fn.Name].(*types.Func)
+	if !ok {
+		return nil
+	}
+
+	recv := obj.Type().(*types.Signature).Recv().Type() //nolint:forcetypeassert
+	if ptr, ok := recv.(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+
+	named, ok := recv.(*types.Named)
+	if !ok {
+		return nil
+	}
+
+	return named.Obj()
+}
+
+// prefixFuncs are the functions that are used to check or strip prefixes by hand.
+var prefixFuncs = map[string]bool{
+	"strings.HasPrefix": true, "strings.TrimPrefix": true, "strings.CutPrefix": true,
+	"bytes.HasPrefix": true, "bytes.TrimPrefix": true, "bytes.CutPrefix": true,
+}
+
+// checkHardCoded reports prefixes of known kinds that are passed as literals to prefix functions and
+// kind numbers that are passed as literals to Registry.LookupNumber.
+func checkHardCoded(pass *analysis.Pass, byNumber map[int64]kindDecl, byShort map[string]kindDecl) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+			if !ok || fn.Pkg() == nil {
+				return true
+			}
+
+			switch {
+			case prefixFuncs[fn.Pkg().Path()+"."+fn.Name()] && len(call.Args) == 2: //nolint:mnd
+				value := pass.TypesInfo.Types[call.Args[1]].Value
+				if value == nil || value.Kind() != constant.String {
+					return true
+				}
+
+				short, found := strings.CutSuffix(constant.StringVal(value), "_")
+				if kind, ok := byShort[short]; ok && found {
+					pass.Reportf(call.Args[1].Pos(), "hard-coded prefix %q of %s, parse the id with its kind instead",
+						short+"_", kind.Type)
+				}
+			case fn.Pkg().Path() == sdulidPath && fn.Name() == "LookupNumber" && len(call.Args) == 1:
+				value := pass.TypesInfo.Types[call.Args[0]].Value
+				if value == nil || value.Kind() != constant.Int {
+					return true
+				}
+
+				n, _ := constant.Int64Val(value)
+				if kind, ok := byNumber[n]; ok {
+					pass.Reportf(call.Args[0].Pos(), "hard-coded kind number %d of %s, use its KindNumber method",
+						n, kind.Type)
+				} else {
+					pass.Reportf(call.Args[0].Pos(), "hard-coded kind number %d", n)
+				}
+			}
+
+			return true
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package sdulidlint_test
+
+import (
+	"testing"
+
+	"github.com/advdv/sdulid/sdulidlint"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	t.Parallel()
+	analysistest.Run(t, analysistest.TestData(), sdulidlint.Analyzer, "a", "b")
+}
@@ -0,0 +1,21 @@
+package a // want package:"kinds\\(a.UserDesc, a.OrderDesc, a.ItemDesc\\)"
+
+type UserDesc struct{}
+
+func (UserDesc) KindNumber() uint16     { return 1 }
+func (UserDesc) KindIdent() string      { return "user" }
+func (UserDesc) KindShortIdent() string { return "usr" }
+
+type OrderDesc struct{} // want `kind number 1 of a.OrderDesc is already used by a.UserDesc at .*a.go:3:6`
+
+func (OrderDesc) KindNumber() uint16     { return 1 }
+func (OrderDesc) KindIdent() string      { return "order" }
+func (OrderDesc) KindShortIdent() string { return "ord" }
+
+const itemShort = "usr"
+
+type ItemDesc struct{} // want `short ident "usr" of a.ItemDesc is already used by a.UserDesc`
+
+func (*ItemDesc) KindNumber() uint16     { return 3 }
+func (*ItemDesc) KindIdent() string      { return "item" }
+func (*ItemDesc) KindShortIdent() string { return itemShort }
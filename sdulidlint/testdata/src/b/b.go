@@ -0,0 +1,28 @@
+package b // want package:"kinds\\(b.MemberDesc\\)"
+
+import (
+	"bytes"
+	"strings"
+
+	"a"
+	"github.com/advdv/sdulid"
+)
+
+type MemberDesc struct{} // want `kind number 3 of b.MemberDesc is already used by a.ItemDesc`
+
+func (MemberDesc) KindNumber() uint16     { return 3 }
+func (MemberDesc) KindIdent() string      { return "member" }
+func (MemberDesc) KindShortIdent() string { return "mbr" }
+
+var _ a.UserDesc
+
+func parse(reg *sdulid.Registry, s string, b []byte) {
+	_ = strings.HasPrefix(s, "usr_")  // want `hard-coded prefix "usr_" of a.UserDesc, parse the id with its kind instead`
+	_ = strings.TrimPrefix(s, "mbr_") // want `hard-coded prefix "mbr_" of b.MemberDesc`
+	_ = bytes.HasPrefix(b, []byte("ord_"))
+	_ = strings.HasPrefix(s, "foo_")
+	_ = strings.HasPrefix(s, "usr")
+	_, _ = reg.LookupNumber(1)   // want `hard-coded kind number 1 of a.UserDesc, use its KindNumber method`
+	_, _ = reg.LookupNumber(999) // want `hard-coded kind number 999`
+	_, _ = reg.LookupNumber(MemberDesc{}.KindNumber())
+}
@@ -0,0 +1,12 @@
+// Package sdulid is a stub of the sdulid package for the analyzer tests.
+package sdulid
+
+type Kind interface {
+	KindNumber() uint16
+	KindIdent() string
+	KindShortIdent() string
+}
+
+type Registry struct{}
+
+func (r *Registry) LookupNumber(n uint16) (Kind, bool) { return nil, false }
@@ -0,0 +1,115 @@
+// Package sdulidmanifest reads the YAML (or JSON) manifest that describes the kinds of an application.
+// The manifest is the input of the sdulidgen code generator and provides the kind metadata for the
+// sdulid command line tool.
+package sdulidmanifest
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/advdv/sdulid"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultPackage is the package name that is used when the manifest doesn't specify one.
+const DefaultPackage = "model"
+
+// Entity represents an entity with a name, short identifier, and kind number.
+type Entity struct {
+	Name       string `yaml:"name"`
+	Ident      string `yaml:"ident"`
+	ShortIdent string `yaml:"short_ident"`
+	Number     int    `yaml:"number"`
+}
+
+// Kind returns the entity as a kind that is only known at runtime.
+func (e Entity) Kind() sdulid.Kind {
+	return entityKind(e)
+}
+
+// entityKind implements sdulid.Kind for an entity that is only known at runtime.
+type entityKind Entity
+
+func (e entityKind) KindNumber() uint16     { return uint16(e.Number) } //nolint:gosec
+func (e entityKind) KindIdent() string      { return e.Ident }
+func (e entityKind) KindShortIdent() string { return e.ShortIdent }
+
+// Manifest describes the entities of an application.
+type Manifest struct {
+	Package    string   `yaml:"package"`
+	ImportPath string   `yaml:"import_path"`
+	Entities   []Entity `yaml:"entities"`
+}
+
+// Kinds returns the kinds of all entities in the manifest.
+func (m *Manifest) Kinds() []sdulid.Kind {
+	kinds := make([]sdulid.Kind, 0, len(m.Entities))
+	for _, entity := range m.Entities {
+		kinds = append(kinds, entity.Kind())
+	}
+
+	return kinds
+}
+
+// Registry returns a registry holding the kinds of all entities in the manifest.
+func (m *Manifest) Registry() (*sdulid.Registry, error) {
+	return sdulid.NewRegistry(m.Kinds()...)
+}
+
+// ReadFile reads and parses the manifest in the named file.
+func ReadFile(name string) (*Manifest, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("error reading manifest: %w", err)
+	}
+
+	return Parse(data)
+}
+
+// Parse parses the manifest, defaults the package and the idents and checks the entities.
+func Parse(data []byte) (*Manifest, error) {
+	var manifest Manifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+
+	if manifest.Package == "" {
+		manifest.Package = DefaultPackage
+	}
+
+	entities, err := Check(manifest.Entities)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest.Entities = entities
+
+	return &manifest, nil
+}
+
+// Check defaults the ident and checks the entities for invalid and duplicate values.
+func Check(entities []Entity) ([]Entity, error) {
+	kinds := make([]sdulid.Kind, 0, len(entities))
+	for i, entity := range entities {
+		if entity.Name == "" || entity.ShortIdent == "" {
+			return nil, fmt.Errorf("entity %d: name and short ident are required", i)
+		}
+
+		if entity.Number < 0 || entity.Number > 1<<16-1 {
+			return nil, fmt.Errorf("invalid kind number for %s: %d is out of range", entity.Name, entity.Number)
+		}
+
+		if entity.Ident == "" {
+			entities[i].Ident = strings.ToLower(entity.Name)
+		}
+
+		kinds = append(kinds, entities[i].Kind())
+	}
+
+	if err := sdulid.ValidateKinds(kinds...); err != nil {
+		return nil, fmt.Errorf("invalid entities: %w", err)
+	}
+
+	return entities, nil
+}
@@ -0,0 +1,72 @@
+package sdulidmanifest_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/advdv/sdulid/sdulidmanifest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSdulidmanifest(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidmanifest")
+}
+
+var _ = Describe("manifest", func() {
+	It("should parse and default", func() {
+		manifest, err := sdulidmanifest.Parse([]byte(`
+entities:
+  - name: Account
+    short_ident: acc
+    number: 1
+  - name: OrgMember
+    ident: org_member
+    short_ident: mbr
+    number: 2
+`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manifest.Package).To(Equal("model"))
+		Expect(manifest.Entities).To(Equal([]sdulidmanifest.Entity{
+			{Name: "Account", Ident: "account", ShortIdent: "acc", Number: 1},
+			{Name: "OrgMember", Ident: "org_member", ShortIdent: "mbr", Number: 2},
+		}))
+	})
+
+	DescribeTable("invalid manifests",
+		func(data, expErr string) {
+			_, err := sdulidmanifest.Parse([]byte(data))
+			Expect(err).To(MatchError(ContainSubstring(expErr)))
+		},
+		Entry("not yaml", `entities: [`, "failed to decode manifest"),
+		Entry("no short ident", `{entities: [{name: Account, number: 1}]}`, "name and short ident are required"),
+		Entry("out of range", `{entities: [{name: Account, short_ident: acc, number: 65536}]}`, "out of range"),
+		Entry("duplicate number", `{entities: [{name: A, short_ident: a, number: 1}, {name: B, short_ident: b, number: 1}]}`,
+			`kind number 1 of "b" is already used by "a"`),
+	)
+
+	It("should read a file into a registry", func() {
+		dir := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "kinds.yaml"), []byte(`
+entities:
+  - {name: Account, short_ident: acc, number: 1}
+`), 0o600)).To(Succeed())
+
+		manifest, err := sdulidmanifest.ReadFile(filepath.Join(dir, "kinds.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+
+		reg, err := manifest.Registry()
+		Expect(err).ToNot(HaveOccurred())
+
+		kind, ok := reg.LookupShortIdent("acc")
+		Expect(ok).To(BeTrue())
+		Expect(kind.KindIdent()).To(Equal("account"))
+		Expect(kind.KindNumber()).To(Equal(uint16(1)))
+
+		_, err = sdulidmanifest.ReadFile(filepath.Join(dir, "missing.yaml"))
+		Expect(err).To(MatchError(os.ErrNotExist))
+	})
+})
@@ -0,0 +1,142 @@
+// Package sdulidmigrate provides migrations for the PostgreSQL DDL of kinds, as generated by
+// sdulid.AllDDL, for teams that use migration tools such as goose or golang-migrate.
+//
+// WriteFiles writes versioned migration files into a migrations directory. Alternatively, Up and Down
+// can be registered as Go migrations, e.g. with goose:
+//
+//	kinds := []sdulid.Kind{userID{}, orderID{}}
+//	goose.AddNamedMigrationContext("00001_kinds.go", sdulidmigrate.Up(kinds...), sdulidmigrate.Down(kinds...))
+//
+// A migration must not change once it is applied, so the Go migrations take a fixed list of kinds
+// rather than a registry that grows over time. Every kind that is added later needs a new migration
+// version of its own, which creates just that kind:
+//
+//	added := []sdulid.Kind{invoiceID{}}
+//	goose.AddNamedMigrationContext("00002_invoices.go", sdulidmigrate.UpKinds(added...), sdulidmigrate.DownKinds(added...))
+package sdulidmigrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/advdv/sdulid"
+)
+
+// Format determines the layout of the migration files.
+type Format int
+
+const (
+	// FormatGolangMigrate writes separate "{version}_{name}.up.sql" and "{version}_{name}.down.sql" files.
+	FormatGolangMigrate Format = iota
+	// FormatGoose writes a single "{version}_{name}.sql" file with goose annotations.
+	FormatGoose
+)
+
+// ErrUnsupportedFormat is returned when writing files in an unknown format.
+var ErrUnsupportedFormat = errors.New("sdulidmigrate: unsupported format")
+
+// WriteFiles writes the migration for all kinds in reg into dir and returns the paths of the written
+// files. Existing files are never overwritten, an error is returned instead.
+func WriteFiles(dir string, version uint64, name string, reg *sdulid.Registry, format Format) ([]string, error) {
+	up, down := sdulid.AllDDL(reg)
+	base := filepath.Join(dir, fmt.Sprintf("%d_%s", version, name))
+
+	var files map[string]string
+
+	switch format {
+	case FormatGolangMigrate:
+		files = map[string]string{base + ".up.sql": up, base + ".down.sql": down}
+	case FormatGoose:
+		// the plpgsql functions contain semicolons, so each script is sent as a single statement.
+		files = map[string]string{base + ".sql": fmt.Sprintf(
+			"-- +goose Up\n-- +goose StatementBegin\n%s-- +goose StatementEnd\n\n"+
+				"-- +goose Down\n-- +goose StatementBegin\n%s-- +goose StatementEnd\n", up, down)}
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedFormat, format)
+	}
+
+	paths := make([]string, 0, len(files))
+	for _, path := range []string{base + ".sql", base + ".up.sql", base + ".down.sql"} {
+		content, ok := files[path]
+		if !ok {
+			continue
+		}
+
+		if err := writeNew(path, content); err != nil {
+			return paths, err
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// writeNew writes content to a file at path, which must not exist yet.
+func writeNew(path, content string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644) //nolint:mnd
+	if err != nil {
+		return fmt.Errorf("failed to create migration file: %w", err)
+	}
+
+	if _, err := f.WriteString(content); err != nil {
+		return errors.Join(fmt.Errorf("failed to write migration file: %w", err), f.Close())
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close migration file: %w", err)
+	}
+
+	return nil
+}
+
+// Up returns a Go migration that creates the DDL for kinds, including the sdulid_kind function that is
+// shared by all kinds. The DDL is generated once, when Up is called. It panics when the kinds are
+// invalid or conflict, see NewRegistry.
+func Up(kinds ...sdulid.Kind) func(ctx context.Context, tx *sql.Tx) error {
+	up, _ := sdulid.AllDDL(sdulid.MustNewRegistry(kinds...))
+
+	return func(ctx context.Context, tx *sql.Tx) error {
+		return exec(ctx, tx, up)
+	}
+}
+
+// Down returns a Go migration that drops the DDL created by Up for the same kinds.
+func Down(kinds ...sdulid.Kind) func(ctx context.Context, tx *sql.Tx) error {
+	_, down := sdulid.AllDDL(sdulid.MustNewRegistry(kinds...))
+
+	return func(ctx context.Context, tx *sql.Tx) error {
+		return exec(ctx, tx, down)
+	}
+}
+
+// UpKinds returns a Go migration that creates the DDL for kinds that are added after the migration of
+// Up, see sdulid.KindDDL. It panics when the kinds are invalid or conflict, see NewRegistry.
+func UpKinds(kinds ...sdulid.Kind) func(ctx context.Context, tx *sql.Tx) error {
+	up, _ := sdulid.KindDDL(sdulid.MustNewRegistry(kinds...).Kinds()...)
+
+	return func(ctx context.Context, tx *sql.Tx) error {
+		return exec(ctx, tx, up)
+	}
+}
+
+// DownKinds returns a Go migration that drops the DDL created by UpKinds for the same kinds.
+func DownKinds(kinds ...sdulid.Kind) func(ctx context.Context, tx *sql.Tx) error {
+	_, down := sdulid.KindDDL(sdulid.MustNewRegistry(kinds...).Kinds()...)
+
+	return func(ctx context.Context, tx *sql.Tx) error {
+		return exec(ctx, tx, down)
+	}
+}
+
+func exec(ctx context.Context, tx *sql.Tx, script string) error {
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return fmt.Errorf("failed to execute migration: %w", err)
+	}
+
+	return nil
+}
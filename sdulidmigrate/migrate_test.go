@@ -0,0 +1,87 @@
+package sdulidmigrate_test
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidmigrate"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSdulidmigrate(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidmigrate")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+type conflictID struct{}
+
+func (conflictID) KindNumber() uint16     { return math.MaxUint16 }
+func (conflictID) KindIdent() string      { return "conflict" }
+func (conflictID) KindShortIdent() string { return "cfl" }
+
+var _ = Describe("write files", func() {
+	var dir string
+
+	reg := sdulid.MustNewRegistry(testID{})
+	up, down := sdulid.AllDDL(reg)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	It("should write golang-migrate files", func() {
+		paths, err := sdulidmigrate.WriteFiles(dir, 3, "kinds", reg, sdulidmigrate.FormatGolangMigrate)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(paths).To(Equal([]string{filepath.Join(dir, "3_kinds.up.sql"), filepath.Join(dir, "3_kinds.down.sql")}))
+
+		Expect(os.ReadFile(paths[0])).To(BeEquivalentTo(up))
+		Expect(os.ReadFile(paths[1])).To(BeEquivalentTo(down))
+	})
+
+	It("should write goose files", func() {
+		paths, err := sdulidmigrate.WriteFiles(dir, 20241103100522, "kinds", reg, sdulidmigrate.FormatGoose)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(paths).To(Equal([]string{filepath.Join(dir, "20241103100522_kinds.sql")}))
+
+		data, err := os.ReadFile(paths[0])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(HavePrefix("-- +goose Up\n-- +goose StatementBegin\n" + up))
+		Expect(string(data)).To(HaveSuffix("-- +goose Down\n-- +goose StatementBegin\n" + down + "-- +goose StatementEnd\n"))
+	})
+
+	It("should not overwrite files", func() {
+		_, err := sdulidmigrate.WriteFiles(dir, 1, "kinds", reg, sdulidmigrate.FormatGoose)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = sdulidmigrate.WriteFiles(dir, 1, "kinds", reg, sdulidmigrate.FormatGoose)
+		Expect(err).To(MatchError(os.ErrExist))
+	})
+
+	It("should reject unknown formats", func() {
+		_, err := sdulidmigrate.WriteFiles(dir, 1, "kinds", reg, sdulidmigrate.Format(42))
+		Expect(err).To(MatchError(sdulidmigrate.ErrUnsupportedFormat))
+	})
+})
+
+var _ = Describe("go migrations", func() {
+	It("should generate the ddl when the migration is declared", func() {
+		Expect(sdulidmigrate.Up(testID{})).ToNot(BeNil())
+		Expect(sdulidmigrate.Down(testID{})).ToNot(BeNil())
+		Expect(sdulidmigrate.UpKinds(testID{})).ToNot(BeNil())
+		Expect(sdulidmigrate.DownKinds(testID{})).ToNot(BeNil())
+
+		Expect(func() { sdulidmigrate.Up(testID{}, conflictID{}) }).To(Panic())
+		Expect(func() { sdulidmigrate.DownKinds(testID{}, conflictID{}) }).To(Panic())
+	})
+})
@@ -0,0 +1,33 @@
+// Package sdulidotel provides OpenTelemetry attribute helpers for self-describing ULIDs, so spans
+// carry IDs and their kinds under consistent keys.
+package sdulidotel
+
+import (
+	"github.com/advdv/sdulid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// IDKey is the attribute key of the full ID in its prefixed text form.
+	IDKey = attribute.Key("sdulid.id")
+	// KindKey is the attribute key of the kind ident, dashboards can filter spans by it.
+	KindKey = attribute.Key("sdulid.kind")
+)
+
+// TraceAttr returns an attribute with the prefixed text form of id under key.
+func TraceAttr[T sdulid.Kind](key string, id sdulid.ID[T]) attribute.KeyValue {
+	return attribute.String(key, id.String())
+}
+
+// SpanKindAttrs returns the attributes for both the full ID and the kind ident of id. Use them as:
+// span.SetAttributes(sdulidotel.SpanKindAttrs(id)...).
+func SpanKindAttrs[T sdulid.Kind](id sdulid.ID[T]) []attribute.KeyValue {
+	var kind T
+
+	return []attribute.KeyValue{IDKey.String(id.String()), KindKey.String(kind.KindIdent())}
+}
+
+// SpanKindAttrsAny is like SpanKindAttrs but for an ID of which the kind is only known at runtime.
+func SpanKindAttrsAny(id sdulid.AnyID) []attribute.KeyValue {
+	return []attribute.KeyValue{IDKey.String(id.String()), KindKey.String(id.Kind().KindIdent())}
+}
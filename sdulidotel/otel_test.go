@@ -0,0 +1,47 @@
+package sdulidotel_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidotel"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestSdulidotel(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidotel")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+var _ = Describe("attributes", func() {
+	id := sdulid.MustParse[testID]("tst_01JBRQS1J5A085FYY2M7ZXXZ")
+
+	It("should create a trace attribute", func() {
+		Expect(sdulidotel.TraceAttr("account.id", id)).To(Equal(
+			attribute.String("account.id", "tst_01JBRQS1J5A085FYY2M7ZXXZ")))
+	})
+
+	It("should create span kind attributes", func() {
+		exp := []attribute.KeyValue{
+			attribute.String("sdulid.id", "tst_01JBRQS1J5A085FYY2M7ZXXZ"),
+			attribute.String("sdulid.kind", "test"),
+		}
+
+		Expect(sdulidotel.SpanKindAttrs(id)).To(Equal(exp))
+
+		reg := sdulid.MustNewRegistry(testID{})
+		aid, _, err := reg.ParseAny("tst_01JBRQS1J5A085FYY2M7ZXXZ")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sdulidotel.SpanKindAttrsAny(aid)).To(Equal(exp))
+	})
+})
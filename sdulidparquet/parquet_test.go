@@ -0,0 +1,96 @@
+package sdulidparquet_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidparquet"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/parquet-go/parquet-go"
+)
+
+func TestSdulidparquet(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidparquet")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+type otherID struct{}
+
+func (otherID) KindNumber() uint16     { return 1 }
+func (otherID) KindIdent() string      { return "other" }
+func (otherID) KindShortIdent() string { return "oth" }
+
+type exportRow struct {
+	ID   [16]byte `parquet:"id"`
+	Name string   `parquet:"name"`
+}
+
+var _ = Describe("parquet", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should round-trip row structs with the kind in the metadata", func() {
+		var buf bytes.Buffer
+		Expect(parquet.Write(&buf, []exportRow{{ID: id1.Array(), Name: "a"}},
+			sdulidparquet.KindMetadata[testID]("id"))).To(Succeed())
+
+		f, err := parquet.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		Expect(err).ToNot(HaveOccurred())
+
+		col, ok := f.Schema().Lookup("id")
+		Expect(ok).To(BeTrue())
+		Expect(col.Node.Type().String()).To(Equal(sdulidparquet.Node().Type().String()))
+
+		kind, ok, err := sdulidparquet.ColumnKind(f, "id")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(kind).To(Equal(sdulid.ManifestKind{Number: math.MaxUint16, Ident: "test", ShortIdent: "tst"}))
+
+		_, ok, err = sdulidparquet.ColumnKind(f, "name")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeFalse())
+
+		rows, err := parquet.Read[exportRow](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sdulid.FromBytes[testID](rows[0].ID[:])).To(Equal(id1))
+	})
+
+	It("should round-trip values of a schema built by hand", func() {
+		schema := parquet.NewSchema("export", parquet.Group{"id": sdulidparquet.Node()})
+
+		var buf bytes.Buffer
+		w := parquet.NewWriter(&buf, schema, sdulidparquet.KindMetadataFor(testID{}, "id"))
+		_, err := w.WriteRows([]parquet.Row{{sdulidparquet.Value(id1).Level(0, 0, 0)}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(w.Close()).To(Succeed())
+
+		r := parquet.NewReader(bytes.NewReader(buf.Bytes()), schema)
+		defer r.Close()
+
+		rows := make([]parquet.Row, 1)
+		n, _ := r.ReadRows(rows)
+		Expect(n).To(Equal(1))
+		Expect(sdulidparquet.FromValue[testID](rows[0][0])).To(Equal(id1))
+	})
+
+	It("should verify the kind", func() {
+		_, err := sdulidparquet.FromValue[otherID](sdulidparquet.Value(id1))
+		Expect(err).To(MatchError(sdulid.ErrInvalidSuffix))
+
+		_, err = sdulidparquet.FromValue[testID](parquet.NullValue())
+		Expect(err).To(MatchError(sdulidparquet.ErrInvalidValue))
+	})
+})
@@ -0,0 +1,30 @@
+// Package sdulidpb provides Protocol Buffers integration for self-describing ULIDs.
+//
+//go:generate protoc --go_out=. --go_opt=paths=source_relative sdulid.proto
+package sdulidpb
+
+import (
+	"errors"
+
+	"github.com/advdv/sdulid"
+)
+
+// ErrValueSize is returned when converting a message of which the value is not exactly 16 bytes.
+var ErrValueSize = errors.New("sdulidpb: value must be exactly 16 bytes")
+
+// ToProto converts id into its Protocol Buffers message.
+func ToProto[T sdulid.Kind](id sdulid.ID[T]) *SDULID {
+	return &SDULID{Value: id.Bytes()}
+}
+
+// FromProto converts the message back into an ID of kind T. It returns sdulid.ErrInvalidSuffix if the
+// message holds an ID of another kind. A nil message (an unset field) results in ErrValueSize.
+func FromProto[T sdulid.Kind](m *SDULID) (id sdulid.ID[T], err error) {
+	if len(m.GetValue()) != len(id.ULID) {
+		return id, ErrValueSize
+	}
+
+	err = id.Scan([16]byte(m.GetValue()))
+
+	return id, err
+}
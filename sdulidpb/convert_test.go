@@ -0,0 +1,63 @@
+package sdulidpb_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidpb"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSdulidpb(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidpb")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+type otherID struct{}
+
+func (otherID) KindNumber() uint16     { return 1 }
+func (otherID) KindIdent() string      { return "other" }
+func (otherID) KindShortIdent() string { return "oth" }
+
+var _ = Describe("convert", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should round trip through the wire format", func() {
+		data, err := proto.Marshal(sdulidpb.ToProto(id1))
+		Expect(err).ToNot(HaveOccurred())
+
+		var msg sdulidpb.SDULID
+		Expect(proto.Unmarshal(data, &msg)).To(Succeed())
+
+		id2, err := sdulidpb.FromProto[testID](&msg)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(id2).To(Equal(id1))
+	})
+
+	It("should validate the kind suffix", func() {
+		_, err := sdulidpb.FromProto[otherID](sdulidpb.ToProto(id1))
+		Expect(err).To(MatchError(sdulid.ErrInvalidSuffix))
+	})
+
+	It("should validate the size", func() {
+		_, err := sdulidpb.FromProto[testID](nil)
+		Expect(err).To(MatchError(sdulidpb.ErrValueSize))
+
+		_, err = sdulidpb.FromProto[testID](&sdulidpb.SDULID{Value: []byte("tst_01JBRQS1J5A085FYY2M7ZXXZ")})
+		Expect(err).To(MatchError(sdulidpb.ErrValueSize))
+	})
+})
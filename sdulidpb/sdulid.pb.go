@@ -0,0 +1,124 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: sdulid.proto
+
+package sdulidpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SDULID holds the 16 raw bytes of a self-describing ULID. The last two bytes describe the kind of
+// entity that is identified.
+type SDULID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         []byte                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SDULID) Reset() {
+	*x = SDULID{}
+	mi := &file_sdulid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SDULID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SDULID) ProtoMessage() {}
+
+func (x *SDULID) ProtoReflect() protoreflect.Message {
+	mi := &file_sdulid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SDULID.ProtoReflect.Descriptor instead.
+func (*SDULID) Descriptor() ([]byte, []int) {
+	return file_sdulid_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SDULID) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+var File_sdulid_proto protoreflect.FileDescriptor
+
+const file_sdulid_proto_rawDesc = "" +
+	"\n" +
+	"\fsdulid.proto\x12\tsdulid.v1\"\x1e\n" +
+	"\x06SDULID\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\fR\x05valueB\"Z github.com/advdv/sdulid/sdulidpbb\x06proto3"
+
+var (
+	file_sdulid_proto_rawDescOnce sync.Once
+	file_sdulid_proto_rawDescData []byte
+)
+
+func file_sdulid_proto_rawDescGZIP() []byte {
+	file_sdulid_proto_rawDescOnce.Do(func() {
+		file_sdulid_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sdulid_proto_rawDesc), len(file_sdulid_proto_rawDesc)))
+	})
+	return file_sdulid_proto_rawDescData
+}
+
+var file_sdulid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_sdulid_proto_goTypes = []any{
+	(*SDULID)(nil), // 0: sdulid.v1.SDULID
+}
+var file_sdulid_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_sdulid_proto_init() }
+func file_sdulid_proto_init() {
+	if File_sdulid_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sdulid_proto_rawDesc), len(file_sdulid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sdulid_proto_goTypes,
+		DependencyIndexes: file_sdulid_proto_depIdxs,
+		MessageInfos:      file_sdulid_proto_msgTypes,
+	}.Build()
+	File_sdulid_proto = out.File
+	file_sdulid_proto_goTypes = nil
+	file_sdulid_proto_depIdxs = nil
+}
@@ -0,0 +1,78 @@
+// Package sdulidprom provides a Prometheus collector that tracks the generation of self-describing
+// ULIDs per kind, for capacity monitoring in high-volume services.
+package sdulidprom
+
+import (
+	"strconv"
+
+	"github.com/advdv/sdulid"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Collector is both a sdulid.Hook and a prometheus.Collector. Pass it to makers as their hook and
+// register it with Prometheus:
+//
+//	col := sdulidprom.NewCollector(reg)
+//	prometheus.MustRegister(col)
+//	mkr := sdulid.NewMonotonicMaker[OrderDesc](nil, 0, sdulid.WithHook(col))
+type Collector struct {
+	reg       *sdulid.Registry
+	made      *prometheus.CounterVec
+	overflows *prometheus.CounterVec
+}
+
+var (
+	_ sdulid.Hook          = (*Collector)(nil)
+	_ prometheus.Collector = (*Collector)(nil)
+)
+
+// NewCollector inits the collector. The "kind" label holds the ident of kinds in reg, or the kind
+// number for kinds that are not registered. reg may be nil.
+func NewCollector(reg *sdulid.Registry) *Collector {
+	return &Collector{
+		reg: reg,
+		made: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "sdulid",
+			Name:      "ids_made_total",
+			Help:      "Number of IDs generated per kind.",
+		}, []string{"kind"}),
+		overflows: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "sdulid",
+			Name:      "monotonic_overflows_total",
+			Help:      "Number of times a monotonic maker ran out of entropy within a millisecond, per kind.",
+		}, []string{"kind"}),
+	}
+}
+
+// OnMake implements sdulid.Hook.
+func (c *Collector) OnMake(kindNumber uint16) {
+	c.made.WithLabelValues(c.label(kindNumber)).Inc()
+}
+
+// OnOverflow implements sdulid.Hook.
+func (c *Collector) OnOverflow(kindNumber uint16) {
+	c.overflows.WithLabelValues(c.label(kindNumber)).Inc()
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.made.Describe(ch)
+	c.overflows.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.made.Collect(ch)
+	c.overflows.Collect(ch)
+}
+
+// label returns the value of the kind label for kindNumber.
+func (c *Collector) label(kindNumber uint16) string {
+	if c.reg != nil {
+		if kind, ok := c.reg.LookupNumber(kindNumber); ok {
+			return kind.KindIdent()
+		}
+	}
+
+	return strconv.FormatUint(uint64(kindNumber), 10)
+}
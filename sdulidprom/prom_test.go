@@ -0,0 +1,64 @@
+package sdulidprom_test
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidprom"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestSdulidprom(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidprom")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+type otherID struct{}
+
+func (otherID) KindNumber() uint16     { return 1 }
+func (otherID) KindIdent() string      { return "other" }
+func (otherID) KindShortIdent() string { return "oth" }
+
+type fixedClock struct{}
+
+func (fixedClock) Now() time.Time { return time.UnixMilli(1730628322885) }
+
+var _ = Describe("collector", func() {
+	It("should count ids and overflows per kind", func() {
+		col := sdulidprom.NewCollector(sdulid.MustNewRegistry(testID{}))
+
+		sdulid.CryptoMaker[testID]{Hook: col}.Make()
+		sdulid.CryptoMaker[otherID]{Hook: col}.Make()
+
+		entropy := bytes.NewReader(bytes.Repeat([]byte{255}, 1024))
+		mkr := sdulid.NewMonotonicMaker[testID](entropy, 1,
+			sdulid.WithMonotonicClock(fixedClock{}), sdulid.WithHook(col))
+		mkr.Make()
+
+		_, err := mkr.New()
+		Expect(err).To(MatchError(sdulid.ErrMonotonicOverflow))
+
+		Expect(testutil.CollectAndCompare(col, strings.NewReader(`
+# HELP sdulid_ids_made_total Number of IDs generated per kind.
+# TYPE sdulid_ids_made_total counter
+sdulid_ids_made_total{kind="1"} 1
+sdulid_ids_made_total{kind="test"} 2
+# HELP sdulid_monotonic_overflows_total Number of times a monotonic maker ran out of entropy within a millisecond, per kind.
+# TYPE sdulid_monotonic_overflows_total counter
+sdulid_monotonic_overflows_total{kind="test"} 1
+`))).To(Succeed())
+	})
+})
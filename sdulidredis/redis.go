@@ -0,0 +1,60 @@
+// Package sdulidredis provides helpers for using self-describing ULIDs with Redis.
+//
+// Keys are namespaced by the kind ident so all keys of a kind can be found with a single pattern. For
+// values, IDs implement encoding.BinaryMarshaler so they are stored as their 16 raw bytes instead of
+// the longer text form. Clients such as go-redis write them with MarshalBinary and read them back with
+// UnmarshalBinary, which checks the kind.
+package sdulidredis
+
+import (
+	"strings"
+
+	"github.com/advdv/sdulid"
+)
+
+// Separator separates the parts of a key.
+const Separator = ":"
+
+// Key returns the key of id: the kind ident, the prefixed text form and any parts, separated by colons.
+// E.g: Key(id, "profile") returns "account:acc_01JBRQS1J5A085FYY2M7ZXXZ:profile".
+func Key[T sdulid.Kind](id sdulid.ID[T], parts ...string) string {
+	var kind T
+
+	return key(kind, id.String(), parts)
+}
+
+// KeyAny is like Key but for an ID of which the kind is only known at runtime.
+func KeyAny(id sdulid.AnyID, parts ...string) string {
+	return key(id.Kind(), id.String(), parts)
+}
+
+// Pattern returns the pattern that matches the keys of all IDs of kind T, for use with SCAN.
+func Pattern[T sdulid.Kind](parts ...string) string {
+	var kind T
+
+	return key(kind, kind.KindShortIdent()+"_*", parts)
+}
+
+func key(kind sdulid.Kind, id string, parts []string) string {
+	var b strings.Builder
+
+	b.WriteString(kind.KindIdent())
+	b.WriteString(Separator)
+	b.WriteString(id)
+
+	for _, part := range parts {
+		b.WriteString(Separator)
+		b.WriteString(part)
+	}
+
+	return b.String()
+}
+
+// Binary is an ID that is stored as its 16 raw bytes. Unlike the embedded ULID, unmarshaling checks
+// that the bytes describe kind T.
+//
+// Deprecated: sdulid.ID implements encoding.BinaryMarshaler and encoding.BinaryUnmarshaler with the
+// same kind check, use it directly.
+type Binary[T sdulid.Kind] struct {
+	sdulid.ID[T]
+}
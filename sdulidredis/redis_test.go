@@ -0,0 +1,75 @@
+package sdulidredis_test
+
+import (
+	"encoding"
+	"math"
+	"path"
+	"testing"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidredis"
+	"github.com/oklog/ulid/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSdulidredis(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidredis")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+type otherID struct{}
+
+func (otherID) KindNumber() uint16     { return 1 }
+func (otherID) KindIdent() string      { return "other" }
+func (otherID) KindShortIdent() string { return "oth" }
+
+var (
+	_ encoding.BinaryMarshaler   = sdulidredis.Binary[testID]{}
+	_ encoding.BinaryUnmarshaler = &sdulidredis.Binary[testID]{}
+)
+
+var _ = Describe("keys", func() {
+	id := sdulid.MustParse[testID]("tst_01JBRQS1J5A085FYY2M7ZXXZ")
+
+	It("should create keys", func() {
+		Expect(sdulidredis.Key(id)).To(Equal("test:tst_01JBRQS1J5A085FYY2M7ZXXZ"))
+		Expect(sdulidredis.Key(id, "profile", "v2")).To(Equal("test:tst_01JBRQS1J5A085FYY2M7ZXXZ:profile:v2"))
+
+		aid, _, err := sdulid.MustNewRegistry(testID{}).ParseAny(id.String())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sdulidredis.KeyAny(aid, "profile")).To(Equal(sdulidredis.Key(id, "profile")))
+	})
+
+	It("should match keys with the pattern", func() {
+		pattern := sdulidredis.Pattern[testID]("profile")
+		Expect(pattern).To(Equal("test:tst_*:profile"))
+		Expect(path.Match(pattern, sdulidredis.Key(id, "profile"))).To(BeTrue())
+		Expect(path.Match(pattern, sdulidredis.Key(id, "other"))).To(BeFalse())
+	})
+})
+
+var _ = Describe("binary", func() {
+	It("should round trip 16 bytes", func() {
+		id := sdulid.Make[testID]()
+
+		data, err := sdulidredis.Binary[testID]{ID: id}.MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(HaveLen(16))
+
+		var b sdulidredis.Binary[testID]
+		Expect(b.UnmarshalBinary(data)).To(Succeed())
+		Expect(b.ID).To(Equal(id))
+
+		var o sdulidredis.Binary[otherID]
+		Expect(o.UnmarshalBinary(data)).To(MatchError(sdulid.ErrInvalidSuffix))
+		Expect(o.UnmarshalBinary(data[:15])).To(MatchError(ulid.ErrDataSize))
+	})
+})
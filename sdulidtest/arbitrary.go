@@ -0,0 +1,58 @@
+package sdulidtest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"iter"
+	"math/rand/v2"
+
+	"github.com/advdv/sdulid"
+	"github.com/oklog/ulid/v2"
+)
+
+// NewFromRand returns an ID of kind T with a timestamp and entropy drawn from rng, so the same seed
+// always yields the same ID. The timestamp is drawn from the full range a ULID can hold.
+func NewFromRand[T sdulid.Kind](rng *rand.Rand) sdulid.ID[T] {
+	var entropy [10]byte
+	binary.BigEndian.PutUint64(entropy[:8], rng.Uint64())
+
+	return newID[T](rng.Uint64N(ulid.MaxTime()+1), entropy)
+}
+
+// Arbitrary returns an endless sequence of IDs of kind T for property tests, e.g. to check that
+// MarshalText and UnmarshalText round-trip. It first yields the edge cases: the minimum and maximum
+// timestamp combined with all-zero and all-one entropy. After that every ID is drawn from rng as with
+// NewFromRand. With rapid, draw the seed of rng from the property's *rapid.T to let it shrink.
+func Arbitrary[T sdulid.Kind](rng *rand.Rand) iter.Seq[sdulid.ID[T]] {
+	return func(yield func(sdulid.ID[T]) bool) {
+		var zeros, ones [10]byte
+		for i := range ones {
+			ones[i] = 0xFF
+		}
+
+		for _, ms := range []uint64{0, ulid.MaxTime()} {
+			for _, entropy := range [][10]byte{zeros, ones} {
+				if !yield(newID[T](ms, entropy)) {
+					return
+				}
+			}
+		}
+
+		for {
+			if !yield(NewFromRand[T](rng)) {
+				return
+			}
+		}
+	}
+}
+
+// newID returns the ID of kind T with the timestamp ms and the entropy, the two trailing bytes of
+// which are replaced by the kind suffix.
+func newID[T sdulid.Kind](ms uint64, entropy [10]byte) sdulid.ID[T] {
+	id, err := sdulid.New[T](sdulid.WithTimestampMS(ms), sdulid.WithEntropy(bytes.NewReader(entropy[:])))
+	if err != nil {
+		panic(err) // unreachable, ms is within range and the entropy is of the right size.
+	}
+
+	return id
+}
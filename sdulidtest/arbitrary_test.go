@@ -0,0 +1,49 @@
+package sdulidtest_test
+
+import (
+	"math/rand/v2"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidtest"
+	"github.com/oklog/ulid/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("arbitrary", func() {
+	It("should be identical for the same seed", func() {
+		id1 := sdulidtest.NewFromRand[testID](rand.New(rand.NewPCG(1, 2)))
+		id2 := sdulidtest.NewFromRand[testID](rand.New(rand.NewPCG(1, 2)))
+		Expect(id1).To(Equal(id2))
+		Expect(id1.Bytes()[14:]).To(Equal([]byte{0xFF, 0xFF}))
+	})
+
+	It("should yield the edge cases first", func() {
+		var ids []sdulid.ID[testID]
+		for id := range sdulidtest.Arbitrary[testID](rand.New(rand.NewPCG(1, 2))) {
+			if ids = append(ids, id); len(ids) == 5 {
+				break
+			}
+		}
+
+		Expect(ids[0].String()).To(Equal("tst_00000000000000000000001Z"))
+		Expect(ids[1].Timestamp()).To(Equal(uint64(0)))
+		Expect(ids[1].Bytes()[6:14]).To(Equal([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}))
+		Expect(ids[2].Timestamp()).To(Equal(ulid.MaxTime()))
+		Expect(ids[3].String()).To(Equal("tst_7ZZZZZZZZZZZZZZZZZZZZZZZ"))
+		Expect(ids[4]).To(Equal(sdulidtest.NewFromRand[testID](rand.New(rand.NewPCG(1, 2)))))
+	})
+
+	It("should round-trip the text form", func() {
+		n := 0
+		for id := range sdulidtest.Arbitrary[testID](rand.New(rand.NewPCG(3, 4))) {
+			parsed, err := sdulid.Parse[testID](id.String())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed).To(Equal(id))
+
+			if n++; n == 1000 {
+				break
+			}
+		}
+	})
+})
@@ -0,0 +1,104 @@
+// Package sdulidtest provides utilities for testing code that generates or consumes self-describing ULIDs.
+package sdulidtest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/advdv/sdulid"
+	"github.com/oklog/ulid/v2"
+)
+
+// Maker generates predictable IDs of kind T for golden-file and snapshot tests. All IDs share the
+// timestamp of a fixed clock, the entropy starts at the seed and is incremented by one for every ID.
+// The IDs are therefore sequential and identical across test runs. It implements sdulid.Maker so it
+// can be injected wherever a maker is accepted. It is safe for concurrent use.
+type Maker[T sdulid.Kind] struct {
+	mu   sync.Mutex
+	ms   uint64
+	next uint64
+	done bool
+}
+
+// NewMaker inits a maker of which the IDs have the timestamp of now and entropy that starts at seed.
+func NewMaker[T sdulid.Kind](seed uint64, now time.Time) *Maker[T] {
+	return &Maker[T]{ms: ulid.Timestamp(now), next: seed}
+}
+
+// New generates the next ID in the sequence. It returns sdulid.ErrMonotonicOverflow when the entropy
+// would wrap around, or an error when the clock is outside of the range a ULID can hold.
+func (m *Maker[T]) New() (id sdulid.ID[T], err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.generate()
+}
+
+// NewBatch generates the next n IDs in the sequence, see New. The sequence only advances when the whole
+// batch succeeds. It returns sdulid.ErrInvalidBatchSize when n is negative.
+func (m *Maker[T]) NewBatch(n int) ([]sdulid.ID[T], error) {
+	if n < 0 {
+		return nil, fmt.Errorf("%w: %d", sdulid.ErrInvalidBatchSize, n)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	prevNext, prevDone := m.next, m.done
+
+	ids := make([]sdulid.ID[T], n)
+	for i := range ids {
+		var err error
+		if ids[i], err = m.generate(); err != nil {
+			m.next, m.done = prevNext, prevDone
+
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
+// MakeBatch is like NewBatch but panics when the IDs couldn't be generated.
+func (m *Maker[T]) MakeBatch(n int) []sdulid.ID[T] {
+	ids, err := m.NewBatch(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return ids
+}
+
+// generate returns the next ID in the sequence, m.mu must be held.
+func (m *Maker[T]) generate() (id sdulid.ID[T], err error) {
+	if m.done {
+		return id, sdulid.ErrMonotonicOverflow
+	}
+
+	// the two trailing bytes of the entropy are replaced by the kind suffix.
+	var buf [10]byte
+	binary.BigEndian.PutUint64(buf[:8], m.next)
+
+	id, err = sdulid.New[T](sdulid.WithTimestampMS(m.ms), sdulid.WithEntropy(bytes.NewReader(buf[:])))
+	if err != nil {
+		return id, err //nolint:wrapcheck
+	}
+
+	m.next++
+	m.done = m.next == 0
+
+	return id, nil
+}
+
+// Make is like New but panics when an ID couldn't be generated.
+func (m *Maker[T]) Make() sdulid.ID[T] {
+	id, err := m.New()
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
@@ -0,0 +1,79 @@
+package sdulidtest_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidtest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSdulidtest(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidtest")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+var _ = Describe("maker", func() {
+	now := time.UnixMilli(1730628322885)
+
+	It("should generate predictable sequential ids", func() {
+		m := sdulidtest.NewMaker[testID](0, now)
+		Expect(m.Make().String()).To(Equal("tst_01JBRQS1J50000000000001Z"))
+		Expect(m.Make().String()).To(Equal("tst_01JBRQS1J50000000000003Z"))
+
+		id3 := m.Make()
+		Expect(id3.Timestamp()).To(Equal(uint64(1730628322885)))
+		Expect(id3.Bytes()[6:14]).To(Equal([]byte{0, 0, 0, 0, 0, 0, 0, 2}))
+
+		ids := m.MakeBatch(2)
+		Expect(ids[0].Bytes()[6:14]).To(Equal([]byte{0, 0, 0, 0, 0, 0, 0, 3}))
+		Expect(ids[1].Bytes()[6:14]).To(Equal([]byte{0, 0, 0, 0, 0, 0, 0, 4}))
+	})
+
+	It("should be identical for the same seed", func() {
+		m1, m2 := sdulidtest.NewMaker[testID](42, now), sdulidtest.NewMaker[testID](42, now)
+		for range 10 {
+			id1, id2 := m1.Make(), m2.Make()
+			Expect(id1).To(Equal(id2))
+		}
+
+		Expect(sdulidtest.NewMaker[testID](43, now).Make()).ToNot(Equal(m1.Make()))
+	})
+
+	It("should error when the entropy would wrap", func() {
+		m := sdulidtest.NewMaker[testID](math.MaxUint64, now)
+		last, err := m.New()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(last.Bytes()[6:14]).To(Equal([]byte{255, 255, 255, 255, 255, 255, 255, 255}))
+
+		_, err = m.New()
+		Expect(err).To(MatchError(sdulid.ErrMonotonicOverflow))
+		Expect(func() { m.Make() }).To(PanicWith(sdulid.ErrMonotonicOverflow))
+
+		_, err = m.NewBatch(1)
+		Expect(err).To(MatchError(sdulid.ErrMonotonicOverflow))
+	})
+
+	It("should only advance the sequence when the whole batch succeeds", func() {
+		m := sdulidtest.NewMaker[testID](math.MaxUint64-1, now)
+		_, err := m.NewBatch(3)
+		Expect(err).To(MatchError(sdulid.ErrMonotonicOverflow))
+		Expect(m.MakeBatch(2)).To(Equal(sdulidtest.NewMaker[testID](math.MaxUint64-1, now).MakeBatch(2)))
+
+		_, err = m.NewBatch(-1)
+		Expect(err).To(MatchError(sdulid.ErrInvalidBatchSize))
+		Expect(m.MakeBatch(0)).To(BeEmpty())
+	})
+})
+
+var _ sdulid.Maker[testID] = (*sdulidtest.Maker[testID])(nil)
@@ -0,0 +1,82 @@
+package sdulidtest
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/advdv/sdulid"
+	"github.com/oklog/ulid/v2"
+	"github.com/onsi/gomega/format"
+	"github.com/onsi/gomega/gcustom"
+	"github.com/onsi/gomega/types"
+)
+
+// HaveKind succeeds if actual describes kind T. Actual can be an ID of any kind, an AnyID or a string
+// in the prefixed or long text form.
+func HaveKind[T sdulid.Kind]() types.GomegaMatcher {
+	var kind T
+
+	return gcustom.MakeMatcher(func(actual any) (bool, error) {
+		switch act := actual.(type) {
+		case string:
+			_, err := sdulid.Parse[T](act)
+
+			return err == nil, nil
+		case interface{ Bytes() []byte }:
+			b := act.Bytes()
+			if len(b) != len(ulid.ULID{}) {
+				return false, fmt.Errorf("HaveKind expects 16 bytes, got %d", len(b))
+			}
+
+			return binary.BigEndian.Uint16(b[14:]) == kind.KindNumber(), nil
+		default:
+			return false, fmt.Errorf("HaveKind expects an id or a string, got:\n%s", format.Object(actual, 1))
+		}
+	}).WithTemplate("Expected:\n    {{printf \"%s\" .Actual}}\n{{.To}} have kind {{.Data}}", kind.KindIdent())
+}
+
+// BeZeroID succeeds if the timestamp and entropy of actual are all zero. Actual can be an ID of any
+// kind or an AnyID.
+func BeZeroID() types.GomegaMatcher {
+	return gcustom.MakeMatcher(func(actual any) (bool, error) {
+		switch act := actual.(type) {
+		case interface{ IsZero() bool }:
+			return act.IsZero(), nil
+		case interface{ Bytes() []byte }:
+			return [14]byte(act.Bytes()[:14]) == [14]byte{}, nil
+		default:
+			return false, fmt.Errorf("BeZeroID expects an id, got:\n%s", format.Object(actual, 1))
+		}
+	}).WithTemplate("Expected:\n    {{printf \"%s\" .Actual}}\n{{.To}} be the zero id")
+}
+
+// HaveTimestampWithin succeeds if the timestamp of actual is within d of t. Actual can be an ID of
+// any kind or an AnyID. Since IDs have millisecond precision, d should be at least a millisecond.
+func HaveTimestampWithin(d time.Duration, t time.Time) types.GomegaMatcher {
+	return gcustom.MakeMatcher(func(actual any) (bool, error) {
+		var at time.Time
+
+		switch act := actual.(type) {
+		case interface{ Time() time.Time }:
+			at = act.Time()
+		case interface{ Time() uint64 }:
+			at = ulid.Time(act.Time())
+		default:
+			return false, fmt.Errorf("HaveTimestampWithin expects an id, got:\n%s", format.Object(actual, 1))
+		}
+
+		diff := at.Sub(t)
+
+		return diff >= -d && diff <= d, nil
+	}).WithTemplate("Expected:\n    {{printf \"%s\" .Actual}}\n{{.To}} have a timestamp within {{.Data}}",
+		fmt.Sprintf("%s of %s", d, t.UTC().Format(time.RFC3339Nano)))
+}
+
+// EqualID succeeds if actual is the same ID as expected. Unlike Equal, failures show both IDs in the
+// prefixed text form.
+func EqualID[T sdulid.Kind](expected sdulid.ID[T]) types.GomegaMatcher {
+	return gcustom.MakeMatcher(func(actual sdulid.ID[T]) (bool, error) {
+		return actual == expected, nil
+	}).WithTemplate("Expected:\n    {{printf \"%s\" .Actual}}\n{{.To}} equal\n    {{.Data}}", expected.String())
+}
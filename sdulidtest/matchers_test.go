@@ -0,0 +1,69 @@
+package sdulidtest_test
+
+import (
+	"time"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidtest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type otherID struct{}
+
+func (otherID) KindNumber() uint16     { return 1 }
+func (otherID) KindIdent() string      { return "other" }
+func (otherID) KindShortIdent() string { return "oth" }
+
+var _ = Describe("matchers", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should match the kind", func() {
+		Expect(id1).To(sdulidtest.HaveKind[testID]())
+		Expect(id1).ToNot(sdulidtest.HaveKind[otherID]())
+		Expect(id1.Any()).To(sdulidtest.HaveKind[testID]())
+		Expect("tst_01JBRQS1J5A085FYY2M7ZXXZ").To(sdulidtest.HaveKind[testID]())
+		Expect("tst_01JBRQS1J5A085FYY2M7ZXXZ").ToNot(sdulidtest.HaveKind[otherID]())
+
+		success, err := sdulidtest.HaveKind[testID]().Match(42)
+		Expect(success).To(BeFalse())
+		Expect(err).To(MatchError(ContainSubstring("expects an id or a string")))
+
+		Expect(sdulidtest.HaveKind[otherID]().FailureMessage(id1)).To(Equal(
+			"Expected:\n    tst_01JBRQS1J5A085FYY2M7ZXXZ\nto have kind other"))
+	})
+
+	It("should match zero ids", func() {
+		Expect(sdulid.ID[testID]{}).To(sdulidtest.BeZeroID())
+		Expect(sdulid.Zero[testID]()).To(sdulidtest.BeZeroID())
+		Expect(id1).ToNot(sdulidtest.BeZeroID())
+		Expect(sdulid.AnyID{}).To(sdulidtest.BeZeroID())
+
+		Expect(sdulidtest.BeZeroID().NegatedFailureMessage(sdulid.Zero[otherID]())).To(Equal(
+			"Expected:\n    oth_000000000000000000000000\nnot to be the zero id"))
+	})
+
+	It("should match the timestamp", func() {
+		t := time.UnixMilli(1730628322885)
+		Expect(id1).To(sdulidtest.HaveTimestampWithin(0, t))
+		Expect(id1).To(sdulidtest.HaveTimestampWithin(time.Second, t.Add(time.Second)))
+		Expect(id1).ToNot(sdulidtest.HaveTimestampWithin(time.Second, t.Add(-time.Second-time.Millisecond)))
+		Expect(id1.Any()).To(sdulidtest.HaveTimestampWithin(time.Millisecond, t))
+		Expect(sdulid.Make[testID]()).To(sdulidtest.HaveTimestampWithin(time.Minute, time.Now()))
+
+		Expect(sdulidtest.HaveTimestampWithin(time.Second, t.Add(time.Hour)).FailureMessage(id1)).To(Equal(
+			"Expected:\n    tst_01JBRQS1J5A085FYY2M7ZXXZ\nto have a timestamp within 1s of 2024-11-03T11:05:22.885Z"))
+	})
+
+	It("should match equal ids", func() {
+		Expect(id1).To(sdulidtest.EqualID(sdulid.MustParse[testID]("tst_01JBRQS1J5A085FYY2M7ZXXZ")))
+		Expect(id1).ToNot(sdulidtest.EqualID(sdulid.Make[testID]()))
+
+		Expect(sdulidtest.EqualID(sdulid.Zero[testID]()).FailureMessage(id1)).To(Equal(
+			"Expected:\n    tst_01JBRQS1J5A085FYY2M7ZXXZ\nto equal\n    tst_00000000000000000000001Z"))
+	})
+})
@@ -0,0 +1,43 @@
+// Package sdulidvalidator integrates self-describing ULIDs with the go-playground validator, so request
+// structs that hold IDs as plain strings still get kind-aware validation at the API boundary.
+package sdulidvalidator
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/advdv/sdulid"
+	"github.com/go-playground/validator/v10"
+)
+
+// Tag is the validation tag that is registered by RegisterValidations. The parameter is the short
+// ident of the expected kind, e.g: `validate:"sdulid=acc"`. Without a parameter any registered kind
+// is accepted: `validate:"sdulid"`.
+const Tag = "sdulid"
+
+// RegisterValidations registers the Tag validation with v. Kinds are looked up in reg, so the kinds
+// referred to by tags must be registered.
+func RegisterValidations(v *validator.Validate, reg *sdulid.Registry) error {
+	if err := v.RegisterValidation(Tag, func(fl validator.FieldLevel) bool {
+		return validate(reg, fl.Field(), fl.Param())
+	}); err != nil {
+		return fmt.Errorf("failed to register validation: %w", err)
+	}
+
+	return nil
+}
+
+// validate reports whether the field is a string holding a valid ID of the kind with the short ident
+// param, or of any registered kind when param is empty.
+func validate(reg *sdulid.Registry, field reflect.Value, param string) bool {
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	_, kind, err := reg.ParseAny(field.String())
+	if err != nil {
+		return false
+	}
+
+	return param == "" || kind.KindShortIdent() == param
+}
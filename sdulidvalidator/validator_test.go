@@ -0,0 +1,92 @@
+package sdulidvalidator_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidvalidator"
+	"github.com/go-playground/validator/v10"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSdulidvalidator(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidvalidator")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+type otherID struct{}
+
+func (otherID) KindNumber() uint16     { return 1 }
+func (otherID) KindIdent() string      { return "other" }
+func (otherID) KindShortIdent() string { return "oth" }
+
+type request struct {
+	TestID  string  `validate:"sdulid=tst"`
+	AnyID   string  `validate:"sdulid"`
+	OtherID *string `validate:"omitempty,sdulid=oth"`
+}
+
+var _ = Describe("validator", func() {
+	var val *validator.Validate
+
+	BeforeEach(func() {
+		val = validator.New(validator.WithRequiredStructEnabled())
+		Expect(sdulidvalidator.RegisterValidations(val, sdulid.MustNewRegistry(testID{}, otherID{}))).To(Succeed())
+	})
+
+	DescribeTable("validate",
+		func(req request, expField string) {
+			err := val.Struct(req)
+			if expField == "" {
+				Expect(err).ToNot(HaveOccurred())
+
+				return
+			}
+
+			var verrs validator.ValidationErrors
+			Expect(err).To(BeAssignableToTypeOf(verrs))
+
+			verrs, _ = err.(validator.ValidationErrors)
+			Expect(verrs).To(HaveLen(1))
+			Expect(verrs[0].Field()).To(Equal(expField))
+			Expect(verrs[0].Tag()).To(Equal(sdulidvalidator.Tag))
+		},
+		Entry("valid", request{
+			TestID: "tst_01JBRQS1J5A085FYY2M7ZXXZ",
+			AnyID:  "oth_01JBRQS1J5A085FYY2M7ZXW0",
+		}, ""),
+		Entry("valid long form and optional", request{
+			TestID:  "01JBRQS1J5A085FYY2M7ZXZZZZ",
+			AnyID:   "tst_01JBRQS1J5A085FYY2M7ZXXZ",
+			OtherID: ptr("oth_01JBRQS1J5A085FYY2M7ZXW0"),
+		}, ""),
+		Entry("wrong kind", request{
+			TestID: "oth_01JBRQS1J5A085FYY2M7ZXW0",
+			AnyID:  "oth_01JBRQS1J5A085FYY2M7ZXW0",
+		}, "TestID"),
+		Entry("unknown kind", request{
+			TestID: "tst_01JBRQS1J5A085FYY2M7ZXXZ",
+			AnyID:  "foo_01JBRQS1J5A085FYY2M7ZXW0",
+		}, "AnyID"),
+		Entry("invalid optional", request{
+			TestID:  "tst_01JBRQS1J5A085FYY2M7ZXXZ",
+			AnyID:   "tst_01JBRQS1J5A085FYY2M7ZXXZ",
+			OtherID: ptr("oth_01JBRQS1J5A085FYY2M7ZXXZ"),
+		}, "OtherID"),
+	)
+
+	It("should not validate other types", func() {
+		Expect(val.Var(42, sdulidvalidator.Tag)).ToNot(Succeed())
+	})
+})
+
+func ptr[V any](v V) *V { return &v }
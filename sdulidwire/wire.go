@@ -0,0 +1,33 @@
+// Package sdulidwire provides the registry and per-kind makers to applications that use wire for
+// dependency injection, so services can depend on a sdulid.Maker instead of calling sdulid.Make.
+// Include ProviderSet in an injector and bind a maker for each kind:
+//
+//	wire.Build(
+//		sdulidwire.ProviderSet,
+//		wire.Value(sdulidwire.Kinds{UserDesc{}, OrderDesc{}}),
+//		wire.InterfaceValue(new(sdulid.Maker[UserDesc]), sdulid.CryptoMaker[UserDesc]{}),
+//		...
+//	)
+package sdulidwire
+
+import (
+	"github.com/advdv/sdulid"
+	"github.com/google/wire"
+)
+
+// ProviderSet provides a *sdulid.Registry from the Kinds that are provided to the injector.
+var ProviderSet = wire.NewSet(ProvideRegistry)
+
+// Kinds lists the kinds that the registry is provided with.
+type Kinds []sdulid.Kind
+
+// ProvideRegistry inits a registry with kinds registered.
+func ProvideRegistry(kinds Kinds) (*sdulid.Registry, error) {
+	return sdulid.NewRegistry(kinds...)
+}
+
+// ProvideMaker returns a sdulid.Maker[T] that generates IDs with entropy from crypto/rand, for
+// providers that wrap it. Both clock and hook may be nil.
+func ProvideMaker[T sdulid.Kind](clock sdulid.Clock, hook sdulid.Hook) sdulid.Maker[T] {
+	return sdulid.CryptoMaker[T]{Clock: clock, Hook: hook}
+}
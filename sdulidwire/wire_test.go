@@ -0,0 +1,33 @@
+package sdulidwire_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/advdv/sdulid"
+	"github.com/advdv/sdulid/sdulidwire"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSdulidwire(t *testing.T) {
+	t.Parallel()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sdulidwire")
+}
+
+type testID struct{}
+
+func (testID) KindNumber() uint16     { return math.MaxUint16 }
+func (testID) KindIdent() string      { return "test" }
+func (testID) KindShortIdent() string { return "tst" }
+
+var _ = Describe("wire", func() {
+	It("should provide the registry and makers", func() {
+		reg, err := sdulidwire.ProvideRegistry(sdulidwire.Kinds{testID{}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reg.Kinds()).To(Equal([]sdulid.Kind{testID{}}))
+
+		Expect(sdulidwire.ProvideMaker[testID](nil, nil).Make().String()).To(HavePrefix("tst_"))
+	})
+})
@@ -0,0 +1,107 @@
+package sdulid
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"maps"
+)
+
+// Set is a set of IDs of kind T, built on a map keyed by the ID's 16 bytes. The zero value is not
+// usable, use NewSet or make(Set[T]) to create one.
+type Set[T Kind] map[ID[T]]struct{}
+
+// NewSet inits a set holding ids.
+func NewSet[T Kind](ids ...ID[T]) Set[T] {
+	s := make(Set[T], len(ids))
+	s.Add(ids...)
+
+	return s
+}
+
+// Add adds ids to the set.
+func (s Set[T]) Add(ids ...ID[T]) {
+	for _, id := range ids {
+		s[id] = struct{}{}
+	}
+}
+
+// Has reports whether id is in the set.
+func (s Set[T]) Has(id ID[T]) bool {
+	_, ok := s[id]
+
+	return ok
+}
+
+// Delete removes ids from the set.
+func (s Set[T]) Delete(ids ...ID[T]) {
+	for _, id := range ids {
+		delete(s, id)
+	}
+}
+
+// Len returns the number of IDs in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// All returns an iterator over the IDs in the set, in no particular order.
+func (s Set[T]) All() iter.Seq[ID[T]] {
+	return maps.Keys(s)
+}
+
+// Union returns a new set with the IDs that are in s, other or both.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	u := make(Set[T], max(len(s), len(other)))
+	maps.Copy(u, s)
+	maps.Copy(u, other)
+
+	return u
+}
+
+// Intersect returns a new set with the IDs that are in both s and other.
+func (s Set[T]) Intersect(other Set[T]) Set[T] {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+
+	i := make(Set[T], len(small))
+	for id := range small {
+		if large.Has(id) {
+			i[id] = struct{}{}
+		}
+	}
+
+	return i
+}
+
+// Slice returns the IDs in the set as a sorted slice.
+func (s Set[T]) Slice() IDSlice[T] {
+	ids := make(IDSlice[T], 0, len(s))
+	for id := range s {
+		ids = append(ids, id)
+	}
+
+	ids.Sort()
+
+	return ids
+}
+
+// MarshalJSON implements the json.Marshaler interface by encoding the set as a sorted JSON array.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return s.Slice().MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface by decoding a JSON array. Duplicate IDs
+// are merged.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var ids []ID[T]
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return fmt.Errorf("failed to decode array: %w", err)
+	}
+
+	*s = NewSet(ids...)
+
+	return nil
+}
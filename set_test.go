@@ -0,0 +1,52 @@
+package sdulid_test
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("set", func() {
+	var id1, id2, id3 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+		id2 = sdulid.MustFromULID[testID]("01JBRQS1J40000000000000000")
+		id3 = sdulid.Max[testID]()
+	})
+
+	It("should add, check and delete", func() {
+		set := sdulid.NewSet(id1, id1)
+		Expect(set.Len()).To(Equal(1))
+
+		set.Add(id2)
+		Expect(set.Has(id2)).To(BeTrue())
+		Expect(set.Has(id3)).To(BeFalse())
+
+		set.Delete(id1)
+		Expect(set.Slice()).To(Equal(sdulid.IDSlice[testID]{id2}))
+		Expect(slices.Collect(set.All())).To(ConsistOf(id2))
+	})
+
+	It("should union and intersect", func() {
+		a, b := sdulid.NewSet(id1, id2), sdulid.NewSet(id2, id3)
+		Expect(a.Union(b).Slice()).To(Equal(sdulid.IDSlice[testID]{id2, id1, id3}))
+		Expect(a.Intersect(b).Slice()).To(Equal(sdulid.IDSlice[testID]{id2}))
+		Expect(a.Len()).To(Equal(2))
+	})
+
+	It("should round trip json", func() {
+		data, err := json.Marshal(sdulid.NewSet(id1, id2))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(`["tst_01JBRQS1J40000000000001Z","tst_01JBRQS1J5A085FYY2M7ZXXZ"]`))
+
+		var set sdulid.Set[testID]
+		Expect(json.Unmarshal([]byte(`["tst_01JBRQS1J5A085FYY2M7ZXXZ","01JBRQS1J5A085FYY2M7ZXXZZZ"]`), &set)).To(Succeed())
+		Expect(set).To(Equal(sdulid.NewSet(id1)))
+
+		Expect(json.Unmarshal([]byte(`["oth_01JBRQS1J5A085FYY2M7ZXW0"]`), &set)).To(MatchError(sdulid.ErrWrongKind))
+	})
+})
@@ -0,0 +1,99 @@
+package sdulid
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// MaxShardBits is the maximum number of entropy bits that can be reserved for a shard.
+const MaxShardBits = 16
+
+// ErrInvalidShard is returned when the shard bits or shard number are out of range.
+var ErrInvalidShard = errors.New("sdulid: invalid shard")
+
+// ShardedMaker generates IDs of kind T of which the upper bits of the entropy hold a shard (or
+// worker) number. Consumers can route by ID with ShardOf without any extra lookups. Since the
+// timestamp comes first the IDs remain sortable by time. Each reserved bit halves the entropy per
+// millisecond, so reserve no more bits than needed. It is safe for concurrent use.
+type ShardedMaker[T Kind] struct {
+	// Clock provides the time of new IDs, it defaults to the wall clock when nil.
+	Clock Clock
+	// Hook is optionally called for every generated ID.
+	Hook Hook
+
+	bits  uint
+	shard uint16
+}
+
+// NewShardedMaker inits a maker that reserves the upper bits of the entropy for shard. It returns
+// ErrInvalidShard when bits exceeds MaxShardBits or shard doesn't fit in bits.
+func NewShardedMaker[T Kind](bits uint, shard uint16) (ShardedMaker[T], error) {
+	if bits == 0 || bits > MaxShardBits || uint32(shard) >= 1<<bits {
+		return ShardedMaker[T]{}, ErrInvalidShard
+	}
+
+	return ShardedMaker[T]{bits: bits, shard: shard}, nil
+}
+
+// New generates a new ID for the shard, it returns an error if reading from crypto/rand fails.
+func (m ShardedMaker[T]) New() (ID[T], error) {
+	id, err := CryptoMaker[T]{Clock: m.Clock, Hook: m.Hook}.New()
+	if err != nil {
+		return id, err
+	}
+
+	m.putShard(&id)
+
+	return id, nil
+}
+
+// NewBatch generates n IDs for the shard that share the same timestamp, see NewBatch.
+func (m ShardedMaker[T]) NewBatch(n int) ([]ID[T], error) {
+	ids, err := CryptoMaker[T]{Clock: m.Clock, Hook: m.Hook}.NewBatch(n)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range ids {
+		m.putShard(&ids[i])
+	}
+
+	return ids, nil
+}
+
+// MakeBatch is like NewBatch but panics when the IDs couldn't be generated.
+func (m ShardedMaker[T]) MakeBatch(n int) []ID[T] {
+	ids, err := m.NewBatch(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return ids
+}
+
+// putShard replaces the upper bits of the entropy of id with the shard.
+func (m ShardedMaker[T]) putShard(id *ID[T]) {
+	mask := uint16(1<<(MaxShardBits-m.bits) - 1)
+	entropy := binary.BigEndian.Uint16(id.ULID[6:8])
+	binary.BigEndian.PutUint16(id.ULID[6:8], m.shard<<(MaxShardBits-m.bits)|entropy&mask)
+}
+
+// Make is like New but panics when an ID couldn't be generated.
+func (m ShardedMaker[T]) Make() ID[T] {
+	id, err := m.New()
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
+
+// ShardOf returns the shard of an ID that was generated by a ShardedMaker reserving the same number
+// of bits. It panics when bits exceeds MaxShardBits.
+func ShardOf[T Kind](id ID[T], bits uint) uint16 {
+	if bits > MaxShardBits {
+		panic(ErrInvalidShard)
+	}
+
+	return binary.BigEndian.Uint16(id.ULID[6:8]) >> (MaxShardBits - bits)
+}
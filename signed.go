@@ -0,0 +1,86 @@
+package sdulid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base32"
+	"errors"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// SignatureSize is the size of the (truncated) HMAC-SHA256 signature of a SignedID in bytes.
+const SignatureSize = 10
+
+// ErrInvalidSignature is returned when the signature of a signed ID doesn't verify.
+var ErrInvalidSignature = errors.New("sdulid: invalid signature")
+
+// signatureEncoding encodes the signature in the same Crockford base32 alphabet as the ID, the
+// signature size is chosen so it encodes without padding.
+var signatureEncoding = base32.NewEncoding(ulid.Encoding).WithPadding(base32.NoPadding)
+
+// SignedID is an ID with an HMAC signature, for exposing IDs to untrusted clients (e.g. in URLs)
+// while detecting forged or enumerated IDs. The text form is the prefixed form of the ID directly
+// followed by 16 characters of signature. Since verifying requires the key, a SignedID can only be
+// decoded through VerifyAndParse.
+type SignedID[T Kind] struct {
+	ID        ID[T]
+	Signature [SignatureSize]byte
+}
+
+// Sign signs id with key. The signature covers all 16 bytes, so it won't verify for another kind.
+func Sign[T Kind](key []byte, id ID[T]) SignedID[T] {
+	return SignedID[T]{ID: id, Signature: signature(key, id.ULID)}
+}
+
+// String returns the signed text form.
+func (s SignedID[T]) String() string {
+	d, _ := s.MarshalText()
+
+	return string(d)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface by returning the signed text form.
+func (s SignedID[T]) MarshalText() ([]byte, error) {
+	dst := make([]byte, s.ID.EncodedSize(), s.ID.EncodedSize()+signatureEncoding.EncodedLen(SignatureSize))
+	if err := s.ID.MarshalTextTo(dst); err != nil {
+		return nil, err
+	}
+
+	return signatureEncoding.AppendEncode(dst, s.Signature[:]), nil
+}
+
+// VerifyAndParse parses the signed text form and verifies the signature with key. It returns
+// ErrInvalidSignature if the signature is missing, malformed or doesn't match.
+func VerifyAndParse[T Kind](key []byte, s string) (id ID[T], err error) {
+	n := len(s) - signatureEncoding.EncodedLen(SignatureSize)
+	if n < 0 {
+		return id, ErrInvalidSignature
+	}
+
+	if err := id.UnmarshalText([]byte(s[:n])); err != nil {
+		return id, err
+	}
+
+	var sig [SignatureSize]byte
+	if _, err := signatureEncoding.Decode(sig[:], []byte(strings.ToUpper(s[n:]))); err != nil {
+		return ID[T]{}, ErrInvalidSignature
+	}
+
+	exp := signature(key, id.ULID)
+	if !hmac.Equal(sig[:], exp[:]) {
+		return ID[T]{}, ErrInvalidSignature
+	}
+
+	return id, nil
+}
+
+// signature computes the truncated HMAC-SHA256 of the id bytes.
+func signature(key []byte, u ulid.ULID) (sig [SignatureSize]byte) {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(u[:])
+	copy(sig[:], mac.Sum(nil))
+
+	return sig
+}
@@ -0,0 +1,64 @@
+package sdulid_test
+
+import (
+	"encoding/json"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("signed", func() {
+	var id1 sdulid.ID[testID]
+	key := []byte("0123456789abcdef0123456789abcdef")
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should sign and verify", func() {
+		signed := sdulid.Sign(key, id1)
+		Expect(signed.String()).To(HavePrefix("tst_01JBRQS1J5A085FYY2M7ZXXZ"))
+		Expect(signed.String()).To(HaveLen(len("tst_01JBRQS1J5A085FYY2M7ZXXZ") + 16))
+
+		id2, err := sdulid.VerifyAndParse[testID](key, signed.String())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(id2).To(Equal(id1))
+
+		data, err := json.Marshal(signed)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(`"` + signed.String() + `"`))
+	})
+
+	It("should be deterministic", func() {
+		Expect(sdulid.Sign(key, id1)).To(Equal(sdulid.Sign(key, id1)))
+		Expect(sdulid.Sign(key, id1)).ToNot(Equal(sdulid.Sign([]byte("other"), id1)))
+	})
+
+	It("should reject tampered ids", func() {
+		text := sdulid.Sign(key, id1).String()
+
+		// another id with the original signature
+		forged := "tst_01JBRQS1J5A085FYY2M8ZXXZ" + text[len("tst_01JBRQS1J5A085FYY2M7ZXXZ"):]
+		_, err := sdulid.VerifyAndParse[testID](key, forged)
+		Expect(err).To(MatchError(sdulid.ErrInvalidSignature))
+
+		_, err = sdulid.VerifyAndParse[testID]([]byte("wrong key"), text)
+		Expect(err).To(MatchError(sdulid.ErrInvalidSignature))
+
+		_, err = sdulid.VerifyAndParse[testID](key, text[:len(text)-1]+"U")
+		Expect(err).To(MatchError(sdulid.ErrInvalidSignature))
+
+		_, err = sdulid.VerifyAndParse[testID](key, "tst_01JBRQS1J5A085FYY2M7ZXXZ")
+		Expect(err).To(HaveOccurred())
+
+		_, err = sdulid.VerifyAndParse[testID](key, "short")
+		Expect(err).To(MatchError(sdulid.ErrInvalidSignature))
+	})
+
+	It("should not verify for another kind", func() {
+		other := sdulid.Sign(key, sdulid.MustFromULID[otherID]("01JBRQS1J5A085FYY2M7ZXWG00"))
+		_, err := sdulid.VerifyAndParse[testID](key, "tst_"+other.String()[len("oth_"):])
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,151 @@
+package sdulid
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// IDSlice is a slice of IDs of kind T with bulk encoding and collection helpers.
+type IDSlice[T Kind] []ID[T]
+
+// Contains reports whether id is present in the slice.
+func (s IDSlice[T]) Contains(id ID[T]) bool {
+	return slices.Contains(s, id)
+}
+
+// Sort sorts the slice in place in ascending order.
+func (s IDSlice[T]) Sort() {
+	slices.SortFunc(s, CompareFunc[T]())
+}
+
+// Dedup sorts the slice in place and returns it with duplicate IDs removed.
+func (s IDSlice[T]) Dedup() IDSlice[T] {
+	s.Sort()
+
+	return slices.Compact(s)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface by encoding the IDs in the prefixed text
+// form, separated by commas. The result is encoded with a single allocation.
+func (s IDSlice[T]) MarshalText() ([]byte, error) {
+	if len(s) == 0 {
+		return []byte{}, nil
+	}
+
+	size := ID[T]{}.EncodedSize()
+	dst := make([]byte, len(s)*(size+1)-1)
+
+	for i, id := range s {
+		off := i * (size + 1)
+		if i > 0 {
+			dst[off-1] = ','
+		}
+
+		encodeTextOf[T](dst[off:off+size], id.ULID)
+	}
+
+	return dst, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface by decoding comma separated IDs in
+// the prefixed or long text form.
+func (s *IDSlice[T]) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*s = IDSlice[T]{}
+
+		return nil
+	}
+
+	parts := bytes.Split(data, []byte{','})
+	ids := make(IDSlice[T], len(parts))
+
+	for i, part := range parts {
+		if err := ids[i].UnmarshalText(part); err != nil {
+			return fmt.Errorf("failed to decode element %d: %w", i, err)
+		}
+	}
+
+	*s = ids
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface by encoding the IDs as a JSON array of strings
+// in the configured JSONForm. The result is encoded with a single allocation. A nil slice is encoded
+// as JSON null.
+func (s IDSlice[T]) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return []byte("null"), nil
+	}
+
+	long := GetJSONForm() == JSONFormLong
+
+	size := ID[T]{}.EncodedSize()
+	if long {
+		size = ulid.EncodedSize
+	}
+
+	dst := make([]byte, 0, 2+len(s)*(size+3)) //nolint:mnd
+	dst = append(dst, '[')
+
+	for i, id := range s {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+
+		dst = append(dst, '"')
+
+		n := len(dst)
+		dst = dst[:n+size]
+
+		if long {
+			_ = id.ULID.MarshalTextTo(dst[n:])
+		} else {
+			encodeTextOf[T](dst[n:], id.ULID)
+		}
+
+		dst = append(dst, '"')
+	}
+
+	return append(dst, ']'), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface by decoding a JSON array of strings.
+func (s *IDSlice[T]) UnmarshalJSON(data []byte) error {
+	var ids []ID[T]
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return fmt.Errorf("failed to decode array: %w", err)
+	}
+
+	*s = ids
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface by returning a Postgres bytea[] array literal, e.g:
+// {"\\x0192f17c...","\\x0192f17d..."}. This allows the slice to be used in queries such as:
+// WHERE id = ANY($1).
+func (s IDSlice[T]) Value() (driver.Value, error) {
+	const elemSize = 2 + 3 + 32 // quotes, escaped \x prefix and the hex bytes
+
+	dst := make([]byte, 0, 2+len(s)*(elemSize+1))
+	dst = append(dst, '{')
+
+	for i, id := range s {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+
+		dst = append(dst, `"\\x`...)
+		dst = hex.AppendEncode(dst, id.ULID[:])
+		dst = append(dst, '"')
+	}
+
+	return string(append(dst, '}')), nil
+}
@@ -0,0 +1,94 @@
+package sdulid_test
+
+import (
+	"encoding/json"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("id slice", func() {
+	var id1, id2 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+		id2 = sdulid.MustFromULID[testID]("01JBRQS1J40000000000000000")
+	})
+
+	It("should contain, sort and dedup", func() {
+		ids := sdulid.IDSlice[testID]{id1, id2, id1}
+		Expect(ids.Contains(id2)).To(BeTrue())
+		Expect(ids.Contains(sdulid.Zero[testID]())).To(BeFalse())
+		Expect(ids.Dedup()).To(Equal(sdulid.IDSlice[testID]{id2, id1}))
+	})
+
+	Describe("text", func() {
+		It("should round trip", func() {
+			data, err := sdulid.IDSlice[testID]{id1, id2}.MarshalText()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal("tst_01JBRQS1J5A085FYY2M7ZXXZ,tst_01JBRQS1J40000000000001Z"))
+
+			var ids sdulid.IDSlice[testID]
+			Expect(ids.UnmarshalText(data)).To(Succeed())
+			Expect(ids).To(Equal(sdulid.IDSlice[testID]{id1, id2}))
+		})
+
+		It("should handle empty", func() {
+			data, err := sdulid.IDSlice[testID]{}.MarshalText()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(BeEmpty())
+
+			var ids sdulid.IDSlice[testID]
+			Expect(ids.UnmarshalText(data)).To(Succeed())
+			Expect(ids).To(BeEmpty())
+		})
+
+		It("should report the failing element", func() {
+			var ids sdulid.IDSlice[testID]
+			Expect(ids.UnmarshalText([]byte("tst_01JBRQS1J5A085FYY2M7ZXXZ,oth_01JBRQS1J5A085FYY2M7ZXW0"))).To(And(
+				MatchError(sdulid.ErrWrongKind), MatchError(ContainSubstring("element 1"))))
+		})
+	})
+
+	Describe("json", func() {
+		It("should round trip", func() {
+			data, err := json.Marshal(sdulid.IDSlice[testID]{id1, id2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`["tst_01JBRQS1J5A085FYY2M7ZXXZ","tst_01JBRQS1J40000000000001Z"]`))
+
+			var ids sdulid.IDSlice[testID]
+			Expect(json.Unmarshal(data, &ids)).To(Succeed())
+			Expect(ids).To(Equal(sdulid.IDSlice[testID]{id1, id2}))
+		})
+
+		It("should marshal nil and empty", func() {
+			data, err := json.Marshal(sdulid.IDSlice[testID](nil))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`null`))
+
+			data, err = json.Marshal(sdulid.IDSlice[testID]{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`[]`))
+		})
+
+		It("should marshal the long form when configured", func() {
+			sdulid.SetJSONForm(sdulid.JSONFormLong)
+			DeferCleanup(sdulid.SetJSONForm, sdulid.JSONFormPrefixed)
+
+			data, err := json.Marshal(sdulid.IDSlice[testID]{id1})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`["01JBRQS1J5A085FYY2M7ZXXZZZ"]`))
+		})
+	})
+
+	It("should value as postgres bytea array literal", func() {
+		v, err := sdulid.IDSlice[testID]{id1, id2}.Value()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(v).To(Equal(`{"\\x0192f17c8645501057fbc2a1ffdeffff","\\x0192f17c86440000000000000000ffff"}`))
+
+		v, err = sdulid.IDSlice[testID]{}.Value()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(v).To(Equal(`{}`))
+	})
+})
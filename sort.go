@@ -0,0 +1,47 @@
+package sdulid
+
+import (
+	"cmp"
+	"slices"
+)
+
+// SortIDs sorts ids in place in ascending order: by time and then by entropy.
+//
+// This is guaranteed to be the same order as a plain string sort of their prefixed text forms, as
+// returned by String, MarshalText and AppendText. All text forms of a kind share the prefix, and the
+// Crockford base32 alphabet that encodes the rest is in ascending ASCII order. The guarantee doesn't
+// hold for text forms of different kinds, nor for the lower case and long forms that parsing also
+// accepts. Use SortStrings to sort those.
+func SortIDs[T Kind](ids []ID[T]) {
+	slices.SortFunc(ids, CompareFunc[T]())
+}
+
+// SortStrings sorts ss, which hold IDs of kind T in any text form that Parse accepts, in place by the
+// IDs they hold. For canonical prefixed text forms this is the same as a plain string sort. It returns
+// the error of the first string that doesn't hold an ID of kind T, in which case ss is left unchanged.
+func SortStrings[T Kind](ss []string) error {
+	type entry struct {
+		id ID[T]
+		s  string
+	}
+
+	entries := make([]entry, len(ss))
+	for i, s := range ss {
+		id, err := Parse[T](s)
+		if err != nil {
+			return err
+		}
+
+		entries[i] = entry{id, s}
+	}
+
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		return cmp.Or(a.id.Compare(b.id), cmp.Compare(a.s, b.s))
+	})
+
+	for i, e := range entries {
+		ss[i] = e.s
+	}
+
+	return nil
+}
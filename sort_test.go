@@ -0,0 +1,49 @@
+package sdulid_test
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("sort", func() {
+	It("should sort text forms like the ids", func() {
+		maker := sdulid.NewMonotonicMaker[otherID](nil, 0)
+		ids := []sdulid.ID[otherID]{sdulid.Zero[otherID](), sdulid.Max[otherID]()}
+		for range 1000 {
+			ids = append(ids, sdulid.Make[otherID](), maker.Make())
+		}
+
+		strs := make([]string, len(ids))
+		for i, id := range ids {
+			strs[i] = id.String()
+		}
+
+		sdulid.SortIDs(ids)
+		slices.Sort(strs)
+
+		for i, id := range ids {
+			Expect(strs[i]).To(Equal(id.String()))
+		}
+	})
+
+	It("should sort strings in any text form", func() {
+		id0, id1, id2 := sdulid.Zero[testID](), sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00"),
+			sdulid.Max[testID]()
+		ss := []string{id2.String(), strings.ToLower(id1.String()), "01JBRQS1J5A085FYY2M7ZXXZZZ", id0.String()}
+
+		Expect(sdulid.SortStrings[testID](ss)).To(Succeed())
+		Expect(ss).To(Equal([]string{
+			id0.String(), "01JBRQS1J5A085FYY2M7ZXXZZZ", strings.ToLower(id1.String()), id2.String(),
+		}))
+	})
+
+	It("should leave strings unchanged on error", func() {
+		ss := []string{sdulid.Max[testID]().String(), "oth_01JBRQS1J5A085FYY2M7ZXW0"}
+		Expect(sdulid.SortStrings[testID](ss)).To(MatchError(sdulid.ErrWrongKind))
+		Expect(ss[0]).To(Equal(sdulid.Max[testID]().String()))
+	})
+})
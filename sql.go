@@ -0,0 +1,52 @@
+package sdulid
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Value implements the sql/driver.Valuer interface by returning the 16 raw bytes of the
+// ID, making it suitable for storing in a Postgres bytea column.
+func (id ID[T]) Value() (driver.Value, error) {
+	return id.ULID.Bytes(), nil
+}
+
+// Scan implements the sql.Scanner interface. It accepts the 16 raw bytes (as a byte slice
+// or array) as well as the prefixed or long text form (as a string). In all cases the
+// trailing two bytes are checked to describe T.
+func (id *ID[T]) Scan(src any) error {
+	switch x := src.(type) {
+	case nil:
+		return nil
+	case string:
+		return id.UnmarshalText([]byte(x))
+	case []byte:
+		if len(x) != len(id.ULID) {
+			return id.UnmarshalText(x)
+		}
+
+		return id.scanBytes(x)
+	case [16]byte:
+		return id.scanBytes(x[:])
+	}
+
+	return ErrScanValue
+}
+
+// scanBytes copies the raw bytes into the id while checking the kind suffix.
+func (id *ID[T]) scanBytes(b []byte) error {
+	var kind T
+
+	var v ulid.ULID
+	copy(v[:], b)
+
+	if binary.BigEndian.Uint16(v[14:]) != kind.KindNumber() {
+		return ErrInvalidSuffix
+	}
+
+	id.ULID = v
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package sdulid_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("sql", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should implement the interfaces", func() {
+		var _ driver.Valuer = id1
+		var _ sql.Scanner = &id1
+	})
+
+	It("should value as raw bytes", func() {
+		v, err := id1.Value()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(v).To(Equal([]byte{1, 146, 241, 124, 134, 69, 80, 16, 87, 251, 194, 161, 255, 222, 255, 255}))
+	})
+
+	DescribeTable("scan",
+		func(src any, expErr error) {
+			var id2 sdulid.ID[testID]
+			err := id2.Scan(src)
+			if expErr != nil {
+				Expect(err).To(MatchError(expErr))
+
+				return
+			}
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(id2).To(Equal(id1))
+		},
+		Entry("raw bytes", []byte{1, 146, 241, 124, 134, 69, 80, 16, 87, 251, 194, 161, 255, 222, 255, 255}, nil),
+		Entry("raw array", [16]byte{1, 146, 241, 124, 134, 69, 80, 16, 87, 251, 194, 161, 255, 222, 255, 255}, nil),
+		Entry("prefixed string", "tst_01JBRQS1J5A085FYY2M7ZXXZ", nil),
+		Entry("long string", "01JBRQS1J5A085FYY2M7ZXXZZZ", nil),
+		Entry("prefixed bytes", []byte("tst_01JBRQS1J5A085FYY2M7ZXXZ"), nil),
+		Entry("raw bytes wrong suffix", []byte{1, 146, 241, 124, 134, 69, 80, 16, 87, 251, 194, 161, 255, 222, 0, 1}, sdulid.ErrInvalidSuffix),
+		Entry("raw array wrong suffix", [16]byte{1, 146, 241, 124, 134, 69, 80, 16, 87, 251, 194, 161, 255, 222, 0, 1}, sdulid.ErrInvalidSuffix),
+		Entry("unsupported type", 42, sdulid.ErrScanValue),
+	)
+
+	It("should leave id untouched on nil", func() {
+		id2 := id1
+		Expect(id2.Scan(nil)).To(Succeed())
+		Expect(id2).To(Equal(id1))
+	})
+})
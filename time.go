@@ -0,0 +1,56 @@
+package sdulid
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Time returns the time that is encoded in the id's timestamp, with millisecond precision.
+func (id ID[T]) Time() time.Time {
+	return ulid.Time(id.ULID.Time())
+}
+
+// Timestamp returns the Unix milliseconds that are encoded in the id.
+func (id ID[T]) Timestamp() uint64 {
+	return id.ULID.Time()
+}
+
+// SetTime sets the timestamp of the id to t (truncated to milliseconds) while leaving the entropy
+// and the kind suffix untouched.
+func (id *ID[T]) SetTime(t time.Time) error {
+	if err := id.ULID.SetTime(ulid.Timestamp(t)); err != nil {
+		return fmt.Errorf("failed to set time: %w", err)
+	}
+
+	return nil
+}
+
+// Age returns the time that has elapsed since the id was generated.
+func (id ID[T]) Age() time.Duration {
+	return time.Since(id.Time())
+}
+
+// FirstInTime returns the smallest possible ID of kind T for the millisecond of t: all entropy bits
+// are zero. Together with LastInTime it allows for created-between queries on the primary key. It
+// panics if t is after ulid.MaxTime.
+func FirstInTime[T Kind](t time.Time) ID[T] {
+	id := Zero[T]()
+	if err := id.SetTime(t); err != nil {
+		panic(err)
+	}
+
+	return id
+}
+
+// LastInTime returns the largest possible ID of kind T for the millisecond of t: all entropy bits
+// are set. It panics if t is after ulid.MaxTime.
+func LastInTime[T Kind](t time.Time) ID[T] {
+	id := Max[T]()
+	if err := id.SetTime(t); err != nil {
+		panic(err)
+	}
+
+	return id
+}
@@ -0,0 +1,60 @@
+package sdulid_test
+
+import (
+	"time"
+
+	"github.com/advdv/sdulid"
+	"github.com/oklog/ulid/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("time", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should return time and timestamp", func() {
+		Expect(id1.Timestamp()).To(Equal(uint64(1730628322885)))
+		Expect(id1.Time()).To(BeTemporally("==", time.UnixMilli(1730628322885)))
+	})
+
+	It("should set time while preserving entropy and suffix", func() {
+		at := time.Date(2020, 1, 2, 3, 4, 5, 6_000_000, time.UTC)
+		Expect(id1.SetTime(at)).To(Succeed())
+		Expect(id1.Time()).To(BeTemporally("==", at))
+		Expect(id1.String()).To(HaveSuffix("085FYY2M7ZXXZ"))
+		Expect(id1.Bytes()[14:]).To(Equal([]byte{255, 255}))
+	})
+
+	It("should error on too large time", func() {
+		Expect(id1.SetTime(time.UnixMilli(int64(ulid.MaxTime()) + 1))).To(MatchError(ulid.ErrBigTime)) //nolint:gosec
+	})
+
+	It("should determine age", func() {
+		id2 := sdulid.MakeAt[testID](time.Now().Add(-time.Hour))
+		Expect(id2.Age()).To(BeNumerically("~", time.Hour, time.Second))
+	})
+})
+
+var _ = Describe("time range", func() {
+	It("should return first and last ids in time", func() {
+		at := time.UnixMilli(1730628322885)
+		first, last := sdulid.FirstInTime[testID](at), sdulid.LastInTime[testID](at)
+		Expect(first.String()).To(Equal("tst_01JBRQS1J50000000000001Z"))
+		Expect(last.String()).To(Equal("tst_01JBRQS1J5ZZZZZZZZZZZZZZ"))
+
+		id1 := sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+		Expect(first.Less(id1)).To(BeTrue())
+		Expect(id1.Less(last)).To(BeTrue())
+		Expect(last.Less(sdulid.FirstInTime[testID](at.Add(time.Millisecond)))).To(BeTrue())
+	})
+
+	It("should panic on too large time", func() {
+		Expect(func() {
+			sdulid.FirstInTime[testID](time.UnixMilli(int64(ulid.MaxTime()) + 1)) //nolint:gosec
+		}).To(PanicWith(MatchError(ulid.ErrBigTime)))
+	})
+})
@@ -0,0 +1,73 @@
+package sdulid
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// ErrInvalidTypeID is returned when a string is not a valid TypeID.
+var ErrInvalidTypeID = errors.New("sdulid: invalid typeid")
+
+// ToTypeID returns id as a TypeID (see https://github.com/jetify-com/typeid): the short ident as type
+// prefix and the 16 bytes as 26 lower case base32 characters. Unlike UUIDv7 based TypeIDs, the version
+// and variant bits are not set, but the spec requires parsers to accept any UUID.
+func ToTypeID[T Kind](id ID[T]) string {
+	var kind T
+
+	return kind.KindShortIdent() + "_" + strings.ToLower(id.ULID.String())
+}
+
+// ParseTypeID parses a TypeID that was created with ToTypeID. The type prefix must be the short ident
+// of T and the last two bytes must describe T, so round-trips are lossless. TypeIDs that are generated
+// elsewhere will fail with ErrInvalidSuffix, use FromTypeID to convert those.
+func ParseTypeID[T Kind](s string) (id ID[T], err error) {
+	u, err := parseTypeID[T](s)
+	if err != nil {
+		return id, err
+	}
+
+	err = id.Scan([16]byte(u))
+
+	return id, err
+}
+
+// FromTypeID converts a TypeID of which the type prefix is the short ident of T into an ID of kind T.
+// It is lossy: the last two bytes of the TypeID are replaced with the kind number.
+func FromTypeID[T Kind](s string) (id ID[T], err error) {
+	id.ULID, err = parseTypeID[T](s)
+	if err != nil {
+		return id, err
+	}
+
+	id.putSuffixBytes()
+
+	return id, nil
+}
+
+// parseTypeID checks the type prefix and decodes the suffix of a TypeID.
+func parseTypeID[T Kind](s string) (u ulid.ULID, err error) {
+	var kind T
+
+	i := strings.LastIndexByte(s, '_')
+	if i < 0 {
+		return u, ErrNoPrefix
+	}
+
+	if prefix := s[:i]; prefix != kind.KindShortIdent() {
+		return u, &WrongKindError{Expected: kind.KindShortIdent(), Actual: prefix}
+	}
+
+	suffix := s[i+1:]
+	if suffix != strings.ToLower(suffix) {
+		return u, fmt.Errorf("%w: suffix must be lower case", ErrInvalidTypeID)
+	}
+
+	if u, err = ulid.ParseStrict(suffix); err != nil {
+		return u, fmt.Errorf("%w: %w", ErrInvalidTypeID, err)
+	}
+
+	return u, nil
+}
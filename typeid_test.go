@@ -0,0 +1,52 @@
+package sdulid_test
+
+import (
+	"github.com/advdv/sdulid"
+	"github.com/oklog/ulid/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("typeid", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should round trip", func() {
+		tid := sdulid.ToTypeID(id1)
+		Expect(tid).To(Equal("tst_01jbrqs1j5a085fyy2m7zxxzzz"))
+		Expect(sdulid.ParseTypeID[testID](tid)).To(Equal(id1))
+
+		for range 100 {
+			id2 := sdulid.Make[testID]()
+			Expect(sdulid.ParseTypeID[testID](sdulid.ToTypeID(id2))).To(Equal(id2))
+		}
+	})
+
+	It("should convert foreign typeids", func() {
+		_, err := sdulid.ParseTypeID[testID]("tst_01h455vb4pex5vsknk084sn02q")
+		Expect(err).To(MatchError(sdulid.ErrInvalidSuffix))
+
+		id2, err := sdulid.FromTypeID[testID]("tst_01h455vb4pex5vsknk084sn02q")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(id2.ULID.String()).To(Equal("01H455VB4PEX5VSKNK084SNZZZ"))
+	})
+
+	DescribeTable("invalid",
+		func(s string, expErr error) {
+			_, err := sdulid.ParseTypeID[testID](s)
+			Expect(err).To(MatchError(expErr))
+
+			_, err = sdulid.FromTypeID[testID](s)
+			Expect(err).To(MatchError(expErr))
+		},
+		Entry("no prefix", "01h455vb4pex5vsknk084sn02q", sdulid.ErrNoPrefix),
+		Entry("other prefix", "user_01h455vb4pex5vsknk084sn02q", sdulid.ErrWrongKind),
+		Entry("upper case", "tst_01H455VB4PEX5VSKNK084SN02Q", sdulid.ErrInvalidTypeID),
+		Entry("too short", "tst_01h455vb4pex5vsknk084sn02", ulid.ErrDataSize),
+		Entry("overflow", "tst_81h455vb4pex5vsknk084sn02q", ulid.ErrOverflow),
+		Entry("invalid character", "tst_01h455vb4pex5vsknk084sn0uq", ulid.ErrInvalidCharacters),
+	)
+})
@@ -0,0 +1,81 @@
+package sdulid
+
+import (
+	"encoding/hex"
+	"errors"
+)
+
+// ErrInvalidUUID is returned when parsing a UUID that is not in the canonical (hyphenated) or the
+// 32 character hexadecimal form, or when a UUIDv7 was expected but another version was provided.
+var ErrInvalidUUID = errors.New("sdulid: invalid uuid")
+
+// UUID returns the 16 bytes of the id in the canonical UUID form, e.g:
+// 0192f17c-8645-5010-57fb-c2a1ffdeffff. Note that the result is generally not a valid RFC 9562
+// UUID since the version and variant bits are not set.
+func (id ID[T]) UUID() string {
+	var dst [36]byte
+	hex.Encode(dst[0:8], id.ULID[0:4])
+	dst[8] = '-'
+	hex.Encode(dst[9:13], id.ULID[4:6])
+	dst[13] = '-'
+	hex.Encode(dst[14:18], id.ULID[6:8])
+	dst[18] = '-'
+	hex.Encode(dst[19:23], id.ULID[8:10])
+	dst[23] = '-'
+	hex.Encode(dst[24:], id.ULID[10:])
+
+	return string(dst[:])
+}
+
+// FromUUID parses a UUID that was produced by UUID back into an ID of kind T. It returns
+// ErrInvalidSuffix if the trailing two bytes don't describe T.
+func FromUUID[T Kind](s string) (id ID[T], err error) {
+	b, err := parseUUID(s)
+	if err != nil {
+		return id, err
+	}
+
+	err = id.scanBytes(b[:])
+
+	return id, err
+}
+
+// FromUUIDv7 parses a UUIDv7 that was generated by another system into an ID of kind T. Since UUIDv7
+// and ULID share the 48 bit millisecond timestamp the time is preserved, the trailing two bytes are
+// overwritten to describe T. It returns ErrInvalidUUID if s is not a version 7 UUID.
+func FromUUIDv7[T Kind](s string) (id ID[T], err error) {
+	b, err := parseUUID(s)
+	if err != nil {
+		return id, err
+	}
+
+	if b[6]>>4 != 7 {
+		return id, ErrInvalidUUID
+	}
+
+	id.ULID = b
+	id.putSuffixBytes()
+
+	return id, nil
+}
+
+// parseUUID decodes a canonical or 32 character hexadecimal UUID.
+func parseUUID(s string) (b [16]byte, err error) {
+	switch len(s) {
+	case 32: //nolint:mnd
+	case 36: //nolint:mnd
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return b, ErrInvalidUUID
+		}
+
+		s = s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	default:
+		return b, ErrInvalidUUID
+	}
+
+	if _, err := hex.Decode(b[:], []byte(s)); err != nil {
+		return b, errors.Join(ErrInvalidUUID, err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,50 @@
+package sdulid_test
+
+import (
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("uuid", func() {
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should encode as uuid", func() {
+		Expect(id1.UUID()).To(Equal("0192f17c-8645-5010-57fb-c2a1ffdeffff"))
+	})
+
+	DescribeTable("from uuid",
+		func(s string, expErr error) {
+			id2, err := sdulid.FromUUID[testID](s)
+			if expErr != nil {
+				Expect(err).To(MatchError(expErr))
+
+				return
+			}
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(id2).To(Equal(id1))
+		},
+		Entry("canonical", "0192f17c-8645-5010-57fb-c2a1ffdeffff", nil),
+		Entry("upper case", "0192F17C-8645-5010-57FB-C2A1FFDEFFFF", nil),
+		Entry("no hyphens", "0192f17c8645501057fbc2a1ffdeffff", nil),
+		Entry("wrong suffix", "0192f17c-8645-5010-57fb-c2a1ffde0001", sdulid.ErrInvalidSuffix),
+		Entry("misplaced hyphens", "0192f17c8-645-5010-57fb-c2a1ffdeffff", sdulid.ErrInvalidUUID),
+		Entry("bad hex", "0192f17c-8645-5010-57fb-c2a1ffdeffzz", sdulid.ErrInvalidUUID),
+		Entry("too short", "0192f17c", sdulid.ErrInvalidUUID),
+	)
+
+	It("should import uuidv7 while preserving the time", func() {
+		id2, err := sdulid.FromUUIDv7[testID]("0192f17c-8645-7010-97fb-c2a1ffde1234")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(id2.Time()).To(Equal(id1.Time()))
+		Expect(id2.UUID()).To(Equal("0192f17c-8645-7010-97fb-c2a1ffdeffff"))
+
+		_, err = sdulid.FromUUIDv7[testID]("0192f17c-8645-4010-97fb-c2a1ffde1234")
+		Expect(err).To(MatchError(sdulid.ErrInvalidUUID))
+	})
+})
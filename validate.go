@@ -0,0 +1,87 @@
+package sdulid
+
+import (
+	"encoding/binary"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Validate checks that s is an ID of kind T in the prefixed or long form, without decoding it. It
+// returns the same errors as Parse but doesn't allocate for valid input, so garbage can be rejected
+// cheaply, e.g. on public endpoints before the database is touched. Errors are returned as a
+// *ParseError.
+func Validate[T Kind](s string) error {
+	var kind T
+
+	if start, err := validate(kind, s); err != nil {
+		return newParseError(s, start, kind.KindShortIdent(), err)
+	}
+
+	return nil
+}
+
+// validate validates s, it returns the offset at which the text form starts after the prefix.
+func validate(kind Kind, s string) (int, error) {
+	short := kind.KindShortIdent()
+	if len(s) > len(short) && s[:len(short)] == short && s[len(short)] == '_' {
+		return len(short) + 1, validateText(s[len(short)+1:], kind.KindNumber())
+	} else if i := strings.IndexByte(s, '_'); i >= 0 {
+		if isAlias(kind, s[:i]) {
+			return i + 1, validateText(s[i+1:], kind.KindNumber())
+		}
+
+		return 0, &WrongKindError{Expected: short, Actual: s[:i]}
+	} else if len(s) != ulid.EncodedSize {
+		return 0, ErrNoPrefix
+	}
+
+	return 0, validateLong(s, kind.KindNumber())
+}
+
+// IsKind reports whether s is in the prefixed text form with the short ident prefix, without decoding
+// it. Since the kind number isn't known, the trailing bits that describe the kind are not checked.
+func IsKind(s string, prefix string) bool {
+	v, ok := strings.CutPrefix(s, prefix+"_")
+
+	return ok && prefix != "" && len(v) == textSize && checkText(decoder(), v) == nil
+}
+
+// validateText validates the text form without the prefix, like decodeText.
+func validateText(v string, kindNumber uint16) error {
+	if len(v) != textSize {
+		return ulid.ErrDataSize
+	}
+
+	dec := decoder()
+	if err := checkText(dec, v); err != nil {
+		return err
+	}
+
+	return checkSuffix(dec, v, kindNumber)
+}
+
+// validateLong validates the long form, like decodeLong followed by the suffix check.
+func validateLong(v string, kindNumber uint16) error {
+	dec := decoder()
+	for i := range len(v) {
+		if dec[v[i]] == 0xFF {
+			return ulid.ErrInvalidCharacters
+		}
+	}
+
+	if dec[v[0]] > 7 { //nolint:mnd
+		return ulid.ErrOverflow
+	}
+
+	// the last four characters hold the 2 bytes of the kind suffix (and 4 bits of entropy).
+	var suffix [2]byte
+	suffix[0] = (dec[v[22]] << 7) | (dec[v[23]] << 2) | (dec[v[24]] >> 3)
+	suffix[1] = (dec[v[24]] << 5) | dec[v[25]]
+
+	if binary.BigEndian.Uint16(suffix[:]) != kindNumber {
+		return ErrInvalidSuffix
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package sdulid_test
+
+import (
+	"github.com/advdv/sdulid"
+	"github.com/oklog/ulid/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("validate", func() {
+	DescribeTable("same result as parse",
+		func(s string, expErr error) {
+			_, parseErr := sdulid.Parse[testID](s)
+			err := sdulid.Validate[testID](s)
+
+			if expErr == nil {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(parseErr).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(expErr))
+				Expect(err).To(Equal(parseErr))
+			}
+		},
+		Entry("prefixed", "tst_01JBRQS1J5A085FYY2M7ZXXZ", nil),
+		Entry("lower case", "tst_01jbrqs1j5a085fyy2m7zxxz", nil),
+		Entry("long", "01JBRQS1J5A085FYY2M7ZXXZZZ", nil),
+		Entry("too short", "tst_01JBRQS1J5A085FYY2M7ZXX", ulid.ErrDataSize),
+		Entry("invalid character", "tst_01JBRQS1J5A085FYY2M7ZXUZ", ulid.ErrInvalidCharacters),
+		Entry("overflow", "tst_81JBRQS1J5A085FYY2M7ZXXZ", ulid.ErrOverflow),
+		Entry("suffix of other kind", "tst_01JBRQS1J5A085FYY2M7ZXW0", sdulid.ErrInvalidSuffix),
+		Entry("wrong kind", "oth_01JBRQS1J5A085FYY2M7ZXW0", sdulid.ErrWrongKind),
+		Entry("no prefix", "01JBRQS1J5A085FYY2M7ZXXZ", sdulid.ErrNoPrefix),
+		Entry("long invalid character", "01JBRQS1J5A085FYY2M7ZUXZZZ", ulid.ErrInvalidCharacters),
+		Entry("long overflow", "81JBRQS1J5A085FYY2M7ZXXZZZ", ulid.ErrOverflow),
+		Entry("long of other kind", "01JBRQS1J5A085FYY2M7ZXW001", sdulid.ErrInvalidSuffix),
+	)
+
+	It("should validate aliases", func() {
+		Expect(sdulid.Validate[renamedID]("old_01JBRQS1J5A085FYY2M7ZX00")).To(Succeed())
+	})
+
+	DescribeTable("is kind",
+		func(s, prefix string, exp bool) {
+			Expect(sdulid.IsKind(s, prefix)).To(Equal(exp))
+		},
+		Entry("prefixed", "tst_01JBRQS1J5A085FYY2M7ZXXZ", "tst", true),
+		Entry("other prefix", "tst_01JBRQS1J5A085FYY2M7ZXXZ", "oth", false),
+		Entry("empty prefix", "_01JBRQS1J5A085FYY2M7ZXXZ", "", false),
+		Entry("long", "01JBRQS1J5A085FYY2M7ZXXZZZ", "tst", false),
+		Entry("too long", "tst_01JBRQS1J5A085FYY2M7ZXXZZ", "tst", false),
+		Entry("invalid character", "tst_01JBRQS1J5A085FYY2M7ZXUZ", "tst", false),
+		Entry("overflow", "tst_81JBRQS1J5A085FYY2M7ZXXZ", "tst", false),
+	)
+})
@@ -0,0 +1,35 @@
+package sdulid
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// MarshalXML implements the xml.Marshaler interface by encoding the id in the prefixed text form as
+// the character data of the element.
+func (id ID[T]) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return enc.EncodeElement(id.String(), start) //nolint:wrapcheck
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface. It accepts the prefixed and the long text
+// form as the character data of the element.
+func (id *ID[T]) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := dec.DecodeElement(&s, &start); err != nil {
+		return fmt.Errorf("failed to decode element: %w", err)
+	}
+
+	return id.UnmarshalText([]byte(s))
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface by encoding the id in the prefixed text
+// form as the attribute value.
+func (id ID[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: id.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface. It accepts the prefixed and the long
+// text form as the attribute value.
+func (id *ID[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return id.UnmarshalText([]byte(attr.Value))
+}
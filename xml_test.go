@@ -0,0 +1,46 @@
+package sdulid_test
+
+import (
+	"encoding/xml"
+
+	"github.com/advdv/sdulid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("xml", func() {
+	type export struct {
+		XMLName xml.Name          `xml:"export"`
+		Ref     sdulid.ID[testID] `xml:"ref,attr"`
+		ID      sdulid.ID[testID] `xml:"id"`
+	}
+
+	var id1 sdulid.ID[testID]
+
+	BeforeEach(func() {
+		id1 = sdulid.MustFromULID[testID]("01JBRQS1J5A085FYY2M7ZXWG00")
+	})
+
+	It("should marshal as element and attribute", func() {
+		data, err := xml.Marshal(export{Ref: id1, ID: id1})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(
+			`<export ref="tst_01JBRQS1J5A085FYY2M7ZXXZ"><id>tst_01JBRQS1J5A085FYY2M7ZXXZ</id></export>`))
+	})
+
+	It("should unmarshal element and attribute", func() {
+		var exp export
+		Expect(xml.Unmarshal([]byte(
+			`<export ref="01JBRQS1J5A085FYY2M7ZXXZZZ"><id>tst_01JBRQS1J5A085FYY2M7ZXXZ</id></export>`), &exp)).To(Succeed())
+		Expect(exp.Ref).To(Equal(id1))
+		Expect(exp.ID).To(Equal(id1))
+	})
+
+	It("should verify the kind", func() {
+		var exp export
+		Expect(xml.Unmarshal([]byte(`<export><id>oth_01JBRQS1J5A085FYY2M7ZXW0</id></export>`), &exp)).
+			To(MatchError(sdulid.ErrWrongKind))
+		Expect(xml.Unmarshal([]byte(`<export ref="oth_01JBRQS1J5A085FYY2M7ZXW0"></export>`), &exp)).
+			To(MatchError(sdulid.ErrWrongKind))
+	})
+})